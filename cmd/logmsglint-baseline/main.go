@@ -0,0 +1,93 @@
+// Команда logmsglint-baseline записывает текущие находки logmsglint в файл
+// baseline, чтобы включить линтер в большом репозитории без исправления
+// всего старого кода сразу:
+//
+//	logmsglint-baseline -config logmsglint.yml -o logmsglint-baseline.json ./...
+//
+// Затем путь к файлу указывается в настройке baseline, и в отчет попадают
+// только новые находки.
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/glebpashkov/linter_go/internal/check"
+	"github.com/glebpashkov/linter_go/internal/configfile"
+	"github.com/glebpashkov/linter_go/pkg/analyzer"
+)
+
+var ErrConfig = errors.New("failed to read configuration")
+
+func main() {
+	configPath := flag.String("config", "", "YAML or JSON file with analyzer settings (same keys as in .golangci.yml)")
+	output := flag.String("o", "logmsglint-baseline.json", "baseline file to write")
+	tests := flag.Bool("test", true, "also analyze test files")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: %s [flags] [packages]\n", filepath.Base(os.Args[0]))
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	patterns := flag.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+
+	count, err := writeBaseline(*configPath, *output, *tests, patterns)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+	fmt.Printf("%s: %d findings\n", *output, count)
+}
+
+func writeBaseline(configPath, output string, tests bool, patterns []string) (int, error) {
+	cfg, err := configfile.Load(configPath)
+	if err != nil {
+		return 0, errors.Join(ErrConfig, err)
+	}
+	// Старый baseline не должен скрывать находки, иначе новый файл потеряет их.
+	cfg.Baseline = ""
+
+	a, err := analyzer.NewAnalyzer(cfg)
+	if err != nil {
+		return 0, errors.Join(ErrConfig, err)
+	}
+
+	findings, err := check.Run(a, tests, patterns)
+	if err != nil {
+		return 0, err
+	}
+
+	root, err := filepath.Abs(filepath.Dir(output))
+	if err != nil {
+		return 0, err
+	}
+
+	sources := make(map[string][]byte)
+	var entries []analyzer.BaselineEntry
+	for _, finding := range findings {
+		filename := finding.Fset.Position(finding.Diagnostic.Pos).Filename
+		src, ok := sources[filename]
+		if !ok {
+			src, err = os.ReadFile(filename)
+			if err != nil {
+				return 0, err
+			}
+			sources[filename] = src
+		}
+
+		if entry, ok := analyzer.NewBaselineEntry(finding.Fset, finding.PkgPath, root, finding.Diagnostic, src); ok {
+			entries = append(entries, entry)
+		}
+	}
+
+	if err := analyzer.WriteBaseline(output, entries); err != nil {
+		return 0, err
+	}
+	return len(entries), nil
+}
@@ -0,0 +1,92 @@
+// Команда logmsglint запускает анализатор без golangci-lint:
+//
+//	logmsglint -config logmsglint.yml ./...
+//	logmsglint -config logmsglint.yml -fix ./...
+//
+// Команда также работает как инструмент go vet:
+//
+//	go vet -vettool=$(which logmsglint) -config=$PWD/logmsglint.yml ./...
+//
+// С флагом -sarif находки записываются в отчет SARIF 2.1.0 для GitHub code scanning:
+//
+//	logmsglint -config logmsglint.yml -sarif logmsglint.sarif ./...
+//
+// Файл настроек в YAML или JSON содержит те же ключи, что и блок settings
+// конфигурации golangci-lint.
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/glebpashkov/linter_go/internal/configfile"
+	"github.com/glebpashkov/linter_go/pkg/analyzer"
+	"golang.org/x/tools/go/analysis/singlechecker"
+)
+
+var ErrConfig = errors.New("failed to read configuration")
+
+func main() {
+	args := os.Args[1:]
+
+	// Отчет SARIF строится из самих диагностик, поэтому этот режим
+	// не использует singlechecker и разбирает флаги сам.
+	if _, ok := lookupFlag(args, "sarif"); ok {
+		if err := runSARIF(args); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	// Анализатор собирается из настроек, поэтому -config читаем до того,
+	// как singlechecker разберет остальные флаги.
+	configPath, _ := lookupFlag(args, "config")
+	cfg, err := configfile.Load(configPath)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Join(ErrConfig, err))
+		os.Exit(1)
+	}
+
+	a, err := analyzer.NewAnalyzer(cfg)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, errors.Join(ErrConfig, err))
+		os.Exit(1)
+	}
+
+	// Флаг регистрируется у анализатора, чтобы его принял singlechecker
+	// и go vet: vet передает инструменту только флаги из его ответа на -flags.
+	a.Flags.String("config", "", "YAML or JSON file with analyzer settings (same keys as in .golangci.yml)")
+
+	singlechecker.Main(a)
+}
+
+// lookupFlag находит значение флага (-name value, --name value, -name=value)
+// до разбора флагов. ok сообщает, что флаг указан, даже без значения.
+// Аргументы после "--" не просматриваются.
+func lookupFlag(args []string, name string) (string, bool) {
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if arg == "--" {
+			return "", false
+		}
+		if !strings.HasPrefix(arg, "-") {
+			continue
+		}
+
+		flagName, value, hasValue := strings.Cut(strings.TrimLeft(arg, "-"), "=")
+		if flagName != name {
+			continue
+		}
+		if hasValue {
+			return value, true
+		}
+		if i+1 < len(args) {
+			return args[i+1], true
+		}
+		return "", true
+	}
+	return "", false
+}
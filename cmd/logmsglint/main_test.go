@@ -0,0 +1,36 @@
+package main
+
+import "testing"
+
+func TestLookupFlag(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		args   []string
+		flag   string
+		want   string
+		wantOK bool
+	}{
+		{name: "без флага", args: []string{"./..."}, flag: "config"},
+		{name: "отдельный аргумент", args: []string{"-config", "a.yml", "./..."}, flag: "config", want: "a.yml", wantOK: true},
+		{name: "двойной дефис", args: []string{"-fix", "--config", "a.yml", "./..."}, flag: "config", want: "a.yml", wantOK: true},
+		{name: "через знак равенства", args: []string{"-c", "1", "--config=/abs/a.yml", "./..."}, flag: "config", want: "/abs/a.yml", wantOK: true},
+		{name: "режим go vet", args: []string{"-config=/abs/a.yml", "/tmp/vet.cfg"}, flag: "config", want: "/abs/a.yml", wantOK: true},
+		{name: "после разделителя", args: []string{"--", "-config", "a.yml"}, flag: "config"},
+		{name: "без значения", args: []string{"-sarif"}, flag: "sarif", wantOK: true},
+		{name: "другой флаг с тем же префиксом", args: []string{"-sarif-x", "a"}, flag: "sarif"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, ok := lookupFlag(tt.args, tt.flag)
+			if got != tt.want || ok != tt.wantOK {
+				t.Fatalf("lookupFlag(%q, %q) = %q, %v, ожидали %q, %v", tt.args, tt.flag, got, ok, tt.want, tt.wantOK)
+			}
+		})
+	}
+}
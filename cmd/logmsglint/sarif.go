@@ -0,0 +1,79 @@
+package main
+
+import (
+	"errors"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/glebpashkov/linter_go/internal/check"
+	"github.com/glebpashkov/linter_go/internal/configfile"
+	"github.com/glebpashkov/linter_go/internal/sarif"
+	"github.com/glebpashkov/linter_go/pkg/analyzer"
+)
+
+// runSARIF анализирует пакеты и пишет отчет SARIF. Находки не считаются
+// ошибкой команды: решение о них принимает code scanning.
+func runSARIF(args []string) error {
+	flags := flag.NewFlagSet(filepath.Base(os.Args[0]), flag.ExitOnError)
+	configPath := flags.String("config", "", "YAML or JSON file with analyzer settings (same keys as in .golangci.yml)")
+	output := flags.String("sarif", "", "write findings as SARIF 2.1.0 to this file (- for stdout)")
+	tests := flags.Bool("test", true, "also analyze test files")
+	flags.Usage = func() {
+		fmt.Fprintf(flags.Output(), "usage: %s -sarif file [-config file] [-test] [packages]\n", flags.Name())
+		flags.PrintDefaults()
+	}
+	// С ExitOnError Parse сам печатает ошибку и usage и завершает команду.
+	_ = flags.Parse(args)
+	if *output == "" {
+		return errors.New("-sarif: output file is required")
+	}
+
+	patterns := flags.Args()
+	if len(patterns) == 0 {
+		patterns = []string{"./..."}
+	}
+
+	cfg, err := configfile.Load(*configPath)
+	if err != nil {
+		return errors.Join(ErrConfig, err)
+	}
+	a, err := analyzer.NewAnalyzer(cfg)
+	if err != nil {
+		return errors.Join(ErrConfig, err)
+	}
+	severities, err := analyzer.RuleSeverities(cfg)
+	if err != nil {
+		return errors.Join(ErrConfig, err)
+	}
+
+	findings, err := check.Run(a, *tests, patterns)
+	if err != nil {
+		return err
+	}
+
+	// Пути в отчете — относительно каталога запуска, то есть корня репозитория в CI.
+	root, err := os.Getwd()
+	if err != nil {
+		return err
+	}
+	report := sarif.NewReport(root, analyzer.Rules(cfg.Language), severities)
+	for _, finding := range findings {
+		report.Add(finding.Fset, finding.Diagnostic)
+	}
+
+	if *output == "-" {
+		return report.Write(os.Stdout)
+	}
+
+	file, err := os.Create(*output)
+	if err != nil {
+		return err
+	}
+	if err := report.Write(file); err != nil {
+		file.Close()
+		return err
+	}
+	return file.Close()
+}
@@ -1,10 +1,14 @@
 module github.com/glebpashkov/linter_go
 
-go 1.22.0
+go 1.25.0
 
-require golang.org/x/tools v0.30.0
+require (
+	github.com/golangci/plugin-module-register v0.1.2
+	golang.org/x/tools v0.44.0
+	gopkg.in/yaml.v3 v3.0.1
+)
 
 require (
-	golang.org/x/mod v0.23.0 // indirect
-	golang.org/x/sync v0.11.0 // indirect
+	golang.org/x/mod v0.35.0 // indirect
+	golang.org/x/sync v0.20.0 // indirect
 )
@@ -0,0 +1,73 @@
+// Package check загружает пакеты и запускает на них анализатор для команд
+// в cmd, которым нужны сами диагностики, а не текстовый отчет singlechecker.
+package check
+
+import (
+	"errors"
+	"fmt"
+	"go/token"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/checker"
+	"golang.org/x/tools/go/packages"
+)
+
+var (
+	ErrLoad     = errors.New("failed to load packages")
+	ErrAnalysis = errors.New("analysis failed")
+)
+
+// Finding — диагностика анализатора и пакет, в котором она найдена.
+type Finding struct {
+	Fset       *token.FileSet
+	PkgPath    string
+	Diagnostic analysis.Diagnostic
+}
+
+// Run загружает пакеты по шаблонам и возвращает диагностики анализатора a.
+// Файлы пакета входят и в обычный, и в тестовый вариант, поэтому одна и та же
+// диагностика возвращается один раз.
+func Run(a *analysis.Analyzer, tests bool, patterns []string) ([]Finding, error) {
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.LoadAllSyntax, Tests: tests}, patterns...)
+	if err != nil {
+		return nil, errors.Join(ErrLoad, err)
+	}
+	if n := packages.PrintErrors(pkgs); n > 0 {
+		return nil, fmt.Errorf("%w: %d errors", ErrLoad, n)
+	}
+
+	graph, err := checker.Analyze([]*analysis.Analyzer{a}, pkgs, nil)
+	if err != nil {
+		return nil, errors.Join(ErrAnalysis, err)
+	}
+
+	type position struct {
+		file    string
+		offset  int
+		message string
+	}
+	seen := make(map[position]struct{})
+
+	var findings []Finding
+	for act := range graph.All() {
+		if !act.IsRoot || act.Analyzer != a {
+			continue
+		}
+		if act.Err != nil {
+			return nil, errors.Join(ErrAnalysis, act.Err)
+		}
+
+		fset := act.Package.Fset
+		for _, diagnostic := range act.Diagnostics {
+			pos := fset.Position(diagnostic.Pos)
+			key := position{file: pos.Filename, offset: pos.Offset, message: diagnostic.Message}
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+
+			findings = append(findings, Finding{Fset: fset, PkgPath: act.Package.PkgPath, Diagnostic: diagnostic})
+		}
+	}
+	return findings, nil
+}
@@ -0,0 +1,38 @@
+// Package configfile читает настройки анализатора из файла для команд
+// в cmd: те же ключи, что в блоке settings конфигурации golangci-lint.
+package configfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/glebpashkov/linter_go/pkg/analyzer"
+	"gopkg.in/yaml.v3"
+)
+
+// Load читает YAML или JSON (по расширению .json) и разбирает его через
+// analyzer.ParseConfig. Пустой путь означает настройки по умолчанию.
+func Load(path string) (analyzer.Config, error) {
+	if path == "" {
+		return analyzer.Config{}, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return analyzer.Config{}, err
+	}
+
+	var raw map[string]any
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		err = json.Unmarshal(data, &raw)
+	} else {
+		err = yaml.Unmarshal(data, &raw)
+	}
+	if err != nil {
+		return analyzer.Config{}, fmt.Errorf("%s: %w", path, err)
+	}
+	return analyzer.ParseConfig(raw)
+}
@@ -0,0 +1,93 @@
+package configfile
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/glebpashkov/linter_go/pkg/analyzer"
+)
+
+func TestLoad(t *testing.T) {
+	t.Parallel()
+
+	want := analyzer.Config{
+		SensitivePatterns: []string{`(?i)\bsession(?:[_-]|\s+)id\b`},
+		ExtraSinks:        map[string]int{"github.com/acme/obs.Infof": 1},
+		Language:          "ru",
+	}
+
+	tests := []struct {
+		name    string
+		file    string
+		content string
+	}{
+		{
+			name: "YAML",
+			file: "logmsglint.yml",
+			content: `sensitive-patterns:
+  - '(?i)\bsession(?:[_-]|\s+)id\b'
+extra-sinks:
+  github.com/acme/obs.Infof: 1
+language: ru
+`,
+		},
+		{
+			name: "JSON",
+			file: "logmsglint.json",
+			content: `{
+	"sensitive_patterns": ["(?i)\\bsession(?:[_-]|\\s+)id\\b"],
+	"extraSinks": {"github.com/acme/obs.Infof": 1},
+	"language": "ru"
+}
+`,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			path := filepath.Join(t.TempDir(), tt.file)
+			if err := os.WriteFile(path, []byte(tt.content), 0o644); err != nil {
+				t.Fatal(err)
+			}
+
+			got, err := Load(path)
+			if err != nil {
+				t.Fatalf("Load(%q) вернул ошибку: %v", path, err)
+			}
+			if !reflect.DeepEqual(got.SensitivePatterns, want.SensitivePatterns) ||
+				!reflect.DeepEqual(got.ExtraSinks, want.ExtraSinks) ||
+				got.Language != want.Language {
+				t.Fatalf("Load(%q) = %+v, ожидали %+v", path, got, want)
+			}
+		})
+	}
+}
+
+func TestLoad_Errors(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	invalid := filepath.Join(dir, "logmsglint.yml")
+	if err := os.WriteFile(invalid, []byte("language: [\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	unknown := filepath.Join(dir, "unknown.yml")
+	if err := os.WriteFile(unknown, []byte("language: xx\n"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	for _, path := range []string{filepath.Join(dir, "missing.yml"), invalid, unknown} {
+		if _, err := Load(path); err == nil {
+			t.Fatalf("Load(%q) должен вернуть ошибку", path)
+		}
+	}
+
+	if cfg, err := Load(""); err != nil || !reflect.DeepEqual(cfg, analyzer.Config{}) {
+		t.Fatalf("Load(\"\") = %+v, %v, ожидали настройки по умолчанию", cfg, err)
+	}
+}
@@ -0,0 +1,311 @@
+// Package sarif пишет диагностики анализатора в формате SARIF 2.1.0, который
+// принимает GitHub code scanning.
+package sarif
+
+import (
+	"cmp"
+	"encoding/json"
+	"go/token"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/glebpashkov/linter_go/pkg/analyzer"
+	"golang.org/x/tools/go/analysis"
+)
+
+const (
+	version   = "2.1.0"
+	schemaURI = "https://json.schemastore.org/sarif-2.1.0.json"
+
+	informationURI = "https://github.com/Inkulet/linter_go"
+	// srcRoot — базовый URI, относительно которого записаны пути файлов.
+	srcRoot = "%SRCROOT%"
+)
+
+// Report накапливает результаты одного запуска.
+type Report struct {
+	root       string
+	rules      []analyzer.RuleInfo
+	severities map[string]string
+	ruleIndex  map[string]int
+	results    []result
+	sources    map[string][]byte
+	readFile   func(string) ([]byte, error)
+}
+
+// NewReport создает отчет. root — каталог, относительно которого записываются
+// пути файлов (обычно корень репозитория), rules — описания правил анализатора,
+// severities — уровни включенных правил по кодам из analyzer.RuleSeverities
+// для описаний правил; уровень каждой находки берется из ее Category.
+func NewReport(root string, rules []analyzer.RuleInfo, severities map[string]string) *Report {
+	index := make(map[string]int, len(rules))
+	for i, rule := range rules {
+		index[rule.Code] = i
+	}
+	return &Report{root: root, rules: rules, severities: severities, ruleIndex: index, sources: make(map[string][]byte), readFile: os.ReadFile}
+}
+
+// Add добавляет диагностику. Код правила и уровень берутся из Category
+// ("LML001/warning"), область — из Pos и End, исправления — из SuggestedFixes.
+func (r *Report) Add(fset *token.FileSet, diagnostic analysis.Diagnostic) {
+	code, severity, _ := analyzer.ParseCategory(diagnostic.Category)
+	res := result{
+		RuleID:    cmp.Or(code, analyzer.AnalyzerName),
+		Level:     level(severity),
+		Message:   message{Text: diagnostic.Message},
+		Locations: []location{r.location(fset, diagnostic.Pos, diagnostic.End)},
+	}
+	if index, ok := r.ruleIndex[code]; ok {
+		res.RuleIndex = &index
+	}
+
+	for _, related := range diagnostic.Related {
+		loc := r.location(fset, related.Pos, related.End)
+		loc.Message = &message{Text: related.Message}
+		res.RelatedLocations = append(res.RelatedLocations, loc)
+	}
+
+	for _, suggested := range diagnostic.SuggestedFixes {
+		res.Fixes = append(res.Fixes, r.fix(fset, suggested))
+	}
+
+	r.results = append(r.results, res)
+}
+
+// Write пишет отчет. Результаты сортируются по файлу и позиции, чтобы отчет
+// не зависел от порядка анализа пакетов.
+func (r *Report) Write(w io.Writer) error {
+	results := slices.Clone(r.results)
+	slices.SortStableFunc(results, func(a, b result) int {
+		pa, pb := a.Locations[0].PhysicalLocation, b.Locations[0].PhysicalLocation
+		return cmp.Or(
+			cmp.Compare(pa.ArtifactLocation.URI, pb.ArtifactLocation.URI),
+			cmp.Compare(pa.Region.StartLine, pb.Region.StartLine),
+			cmp.Compare(pa.Region.StartColumn, pb.Region.StartColumn),
+			cmp.Compare(a.RuleID, b.RuleID),
+		)
+	})
+	if results == nil {
+		results = []result{}
+	}
+
+	rules := make([]reportingDescriptor, 0, len(r.rules))
+	for _, rule := range r.rules {
+		descriptor := reportingDescriptor{
+			ID:               rule.Code,
+			Name:             rule.ID,
+			ShortDescription: message{Text: rule.Title},
+			Help:             &multiformatMessage{Text: rule.Doc, Markdown: rule.Doc},
+			HelpURI:          rule.URL,
+		}
+		if severity, ok := r.severities[rule.Code]; ok {
+			descriptor.DefaultConfiguration = &reportingConfiguration{Level: level(severity)}
+		}
+		if rule.Security {
+			descriptor.Properties = &properties{Tags: []string{"security"}}
+		}
+		rules = append(rules, descriptor)
+	}
+
+	log := sarifLog{
+		Version: version,
+		Schema:  schemaURI,
+		Runs: []run{{
+			Tool:               tool{Driver: driver{Name: analyzer.AnalyzerName, InformationURI: informationURI, Rules: rules}},
+			OriginalURIBaseIDs: map[string]artifactLocation{srcRoot: {URI: fileURI(r.root) + "/"}},
+			ColumnKind:         "utf16CodeUnits",
+			Results:            results,
+		}},
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(log)
+}
+
+func (r *Report) fix(fset *token.FileSet, suggested analysis.SuggestedFix) fix {
+	f := fix{Description: message{Text: suggested.Message}}
+	changes := make(map[string]int)
+	for _, edit := range suggested.TextEdits {
+		loc := r.location(fset, edit.Pos, edit.End)
+		artifact := loc.PhysicalLocation.ArtifactLocation
+		i, ok := changes[artifact.URI]
+		if !ok {
+			i = len(f.ArtifactChanges)
+			changes[artifact.URI] = i
+			f.ArtifactChanges = append(f.ArtifactChanges, artifactChange{ArtifactLocation: artifact})
+		}
+		f.ArtifactChanges[i].Replacements = append(f.ArtifactChanges[i].Replacements, replacement{
+			DeletedRegion:   loc.PhysicalLocation.Region,
+			InsertedContent: &artifactContent{Text: string(edit.NewText)},
+		})
+	}
+	return f
+}
+
+// location переводит позиции в SARIF: строки с 1, колонки в единицах UTF-16,
+// как требует columnKind utf16CodeUnits. End без значения означает точку.
+func (r *Report) location(fset *token.FileSet, pos, end token.Pos) location {
+	start := fset.Position(pos)
+	reg := region{StartLine: start.Line, StartColumn: r.column(start)}
+	if end.IsValid() {
+		finish := fset.Position(end)
+		reg.EndLine, reg.EndColumn = finish.Line, r.column(finish)
+	}
+	return location{PhysicalLocation: physicalLocation{ArtifactLocation: r.artifact(start.Filename), Region: reg}}
+}
+
+// column пересчитывает байтовую колонку go/token в UTF-16. Если файл не
+// прочитать, остается байтовая колонка.
+func (r *Report) column(pos token.Position) int {
+	src, ok := r.sources[pos.Filename]
+	if !ok {
+		src, _ = r.readFile(pos.Filename)
+		r.sources[pos.Filename] = src
+	}
+
+	lineStart := pos.Offset - (pos.Column - 1)
+	if src == nil || lineStart < 0 || pos.Offset > len(src) {
+		return pos.Column
+	}
+	return len(utf16.Encode([]rune(string(src[lineStart:pos.Offset])))) + 1
+}
+
+// artifact записывает путь относительно root с прямыми слешами; файлы вне
+// root (например, из кеша модулей) записываются абсолютным file URI.
+func (r *Report) artifact(filename string) artifactLocation {
+	if rel, err := filepath.Rel(r.root, filename); err == nil && filepath.IsLocal(rel) {
+		return artifactLocation{URI: (&url.URL{Path: filepath.ToSlash(rel)}).String(), URIBaseID: srcRoot}
+	}
+	return artifactLocation{URI: fileURI(filename)}
+}
+
+func fileURI(path string) string {
+	path = filepath.ToSlash(path)
+	if !strings.HasPrefix(path, "/") {
+		// Путь Windows (C:/...) в file URI начинается с третьего слеша.
+		path = "/" + path
+	}
+	return (&url.URL{Scheme: "file", Path: path}).String()
+}
+
+// level переводит уровень правила в уровень SARIF.
+func level(severity string) string {
+	switch severity {
+	case "warning":
+		return "warning"
+	case "info":
+		return "note"
+	}
+	return "error"
+}
+
+// Подмножество объектной модели SARIF 2.1.0, которое нужно отчету.
+type (
+	sarifLog struct {
+		Version string `json:"version"`
+		Schema  string `json:"$schema"`
+		Runs    []run  `json:"runs"`
+	}
+
+	run struct {
+		Tool               tool                        `json:"tool"`
+		OriginalURIBaseIDs map[string]artifactLocation `json:"originalUriBaseIds,omitempty"`
+		ColumnKind         string                      `json:"columnKind"`
+		Results            []result                    `json:"results"`
+	}
+
+	tool struct {
+		Driver driver `json:"driver"`
+	}
+
+	driver struct {
+		Name           string                `json:"name"`
+		InformationURI string                `json:"informationUri"`
+		Rules          []reportingDescriptor `json:"rules"`
+	}
+
+	reportingDescriptor struct {
+		ID               string              `json:"id"`
+		Name             string              `json:"name"`
+		ShortDescription message             `json:"shortDescription"`
+		Help             *multiformatMessage `json:"help,omitempty"`
+		HelpURI          string              `json:"helpUri,omitempty"`
+		// DefaultConfiguration есть только у включенных правил.
+		DefaultConfiguration *reportingConfiguration `json:"defaultConfiguration,omitempty"`
+		Properties           *properties             `json:"properties,omitempty"`
+	}
+
+	reportingConfiguration struct {
+		Level string `json:"level"`
+	}
+
+	properties struct {
+		Tags []string `json:"tags,omitempty"`
+	}
+
+	result struct {
+		RuleID           string     `json:"ruleId"`
+		RuleIndex        *int       `json:"ruleIndex,omitempty"`
+		Level            string     `json:"level"`
+		Message          message    `json:"message"`
+		Locations        []location `json:"locations"`
+		RelatedLocations []location `json:"relatedLocations,omitempty"`
+		Fixes            []fix      `json:"fixes,omitempty"`
+	}
+
+	message struct {
+		Text string `json:"text"`
+	}
+
+	multiformatMessage struct {
+		Text     string `json:"text"`
+		Markdown string `json:"markdown,omitempty"`
+	}
+
+	location struct {
+		PhysicalLocation physicalLocation `json:"physicalLocation"`
+		Message          *message         `json:"message,omitempty"`
+	}
+
+	physicalLocation struct {
+		ArtifactLocation artifactLocation `json:"artifactLocation"`
+		Region           region           `json:"region"`
+	}
+
+	artifactLocation struct {
+		URI       string `json:"uri"`
+		URIBaseID string `json:"uriBaseId,omitempty"`
+	}
+
+	region struct {
+		StartLine   int `json:"startLine"`
+		StartColumn int `json:"startColumn"`
+		EndLine     int `json:"endLine,omitempty"`
+		EndColumn   int `json:"endColumn,omitempty"`
+	}
+
+	fix struct {
+		Description     message          `json:"description"`
+		ArtifactChanges []artifactChange `json:"artifactChanges"`
+	}
+
+	artifactChange struct {
+		ArtifactLocation artifactLocation `json:"artifactLocation"`
+		Replacements     []replacement    `json:"replacements"`
+	}
+
+	replacement struct {
+		DeletedRegion   region           `json:"deletedRegion"`
+		InsertedContent *artifactContent `json:"insertedContent,omitempty"`
+	}
+
+	artifactContent struct {
+		Text string `json:"text"`
+	}
+)
@@ -0,0 +1,139 @@
+package sarif
+
+import (
+	"bytes"
+	"encoding/json"
+	"go/token"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/glebpashkov/linter_go/pkg/analyzer"
+	"golang.org/x/tools/go/analysis"
+)
+
+func TestReport(t *testing.T) {
+	t.Parallel()
+
+	root := filepath.FromSlash("/repo")
+	filename := filepath.Join(root, "cmd", "main.go")
+	src := "package main\n\nfunc main() { /* ключ */ slog.Info(\"password reset\") }\n"
+
+	fset := token.NewFileSet()
+	file := fset.AddFile(filename, -1, len(src))
+	file.SetLinesForContent([]byte(src))
+	pos := func(s string) token.Pos {
+		return file.Pos(strings.Index(src, s))
+	}
+	literal := pos(`"password reset"`)
+
+	severities, err := analyzer.RuleSeverities(analyzer.Config{Rules: map[string]analyzer.RuleConfig{
+		"LML001":    {Severity: "info"},
+		"sensitive": {Severity: "warning"},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	report := NewReport(root, analyzer.Rules(""), severities)
+	report.readFile = func(name string) ([]byte, error) {
+		if name != filename {
+			t.Fatalf("неожиданное чтение файла %q", name)
+		}
+		return []byte(src), nil
+	}
+	report.Add(fset, analysis.Diagnostic{
+		Pos:      literal,
+		End:      literal + token.Pos(len(`"password reset"`)),
+		Category: "LML004/warning",
+		Message:  "LML004: log message contains potentially sensitive data",
+		SuggestedFixes: []analysis.SuggestedFix{{
+			Message: "fix log message",
+			TextEdits: []analysis.TextEdit{{
+				Pos:     literal,
+				End:     literal + token.Pos(len(`"password reset"`)),
+				NewText: []byte(`"[redacted] reset"`),
+			}},
+		}},
+	})
+	report.Add(fset, analysis.Diagnostic{
+		Pos:      pos("func"),
+		Category: "LML001/info",
+		Message:  "LML001: log message must start with a lowercase English letter",
+	})
+
+	var buf bytes.Buffer
+	if err := report.Write(&buf); err != nil {
+		t.Fatalf("не удалось записать отчет: %v", err)
+	}
+
+	var log sarifLog
+	if err := json.Unmarshal(buf.Bytes(), &log); err != nil {
+		t.Fatalf("отчет не разбирается как JSON: %v\n%s", err, buf.String())
+	}
+	if log.Version != "2.1.0" || len(log.Runs) != 1 {
+		t.Fatalf("неожиданный заголовок отчета: %+v", log)
+	}
+	run := log.Runs[0]
+
+	rules := analyzer.Rules("")
+	if len(run.Tool.Driver.Rules) != len(rules) {
+		t.Fatalf("ожидалось %d правил, получено %d", len(rules), len(run.Tool.Driver.Rules))
+	}
+	for i, rule := range run.Tool.Driver.Rules {
+		security := rule.Properties != nil && reflect.DeepEqual(rule.Properties.Tags, []string{"security"})
+		if rule.ID != rules[i].Code || rule.HelpURI != rules[i].URL || security != rules[i].Security || rule.DefaultConfiguration == nil {
+			t.Fatalf("неожиданное описание правила: %+v", rule)
+		}
+	}
+
+	if len(run.Results) != 2 {
+		t.Fatalf("ожидалось 2 результата, получено %d", len(run.Results))
+	}
+
+	// Результаты отсортированы по позиции: сначала LML001 в начале строки 3.
+	first := run.Results[0]
+	if first.RuleID != "LML001" || first.Level != "note" || *first.RuleIndex != 0 {
+		t.Fatalf("неожиданный результат: %+v", first)
+	}
+	if got, want := first.Locations[0].PhysicalLocation.Region, (region{StartLine: 3, StartColumn: 1}); got != want {
+		t.Fatalf("неожиданная область: got=%+v want=%+v", got, want)
+	}
+
+	second := run.Results[1]
+	if second.RuleID != "LML004" || second.Level != "warning" || *second.RuleIndex != 3 || second.Message.Text != "LML004: log message contains potentially sensitive data" {
+		t.Fatalf("неожиданный результат: %+v", second)
+	}
+
+	// Колонки считаются в UTF-16: кириллица занимает два байта, но одну единицу.
+	wantRegion := region{StartLine: 3, StartColumn: 36, EndLine: 3, EndColumn: 52}
+	wantArtifact := artifactLocation{URI: "cmd/main.go", URIBaseID: srcRoot}
+	if got := second.Locations[0].PhysicalLocation; got.Region != wantRegion || got.ArtifactLocation != wantArtifact {
+		t.Fatalf("неожиданное положение: got=%+v want=%+v %+v", got, wantRegion, wantArtifact)
+	}
+
+	wantFixes := []fix{{
+		Description: message{Text: "fix log message"},
+		ArtifactChanges: []artifactChange{{
+			ArtifactLocation: wantArtifact,
+			Replacements: []replacement{{
+				DeletedRegion:   wantRegion,
+				InsertedContent: &artifactContent{Text: `"[redacted] reset"`},
+			}},
+		}},
+	}}
+	if !reflect.DeepEqual(second.Fixes, wantFixes) {
+		t.Fatalf("неожиданные исправления: got=%+v want=%+v", second.Fixes, wantFixes)
+	}
+}
+
+func TestLevel(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{"error": "error", "warning": "warning", "info": "note", "": "error"}
+	for severity, want := range tests {
+		if got := level(severity); got != want {
+			t.Fatalf("level(%q) = %q, ожидали %q", severity, got, want)
+		}
+	}
+}
@@ -1,36 +1,59 @@
 package analyzer
 
 import (
-	"errors"
-	"fmt"
+	"bytes"
+	"cmp"
+	"encoding/json"
 	"go/ast"
+	"go/constant"
 	"go/token"
 	"go/types"
+	"math"
 	"regexp"
+	"slices"
 	"strconv"
 	"strings"
 	"unicode"
 	"unicode/utf8"
 
 	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
 )
 
-const (
-	AnalyzerName = "logmsglint"
-
-	diagStartLower  = "лог-сообщение должно начинаться со строчной английской буквы"
-	diagEnglishOnly = "лог-сообщение должно содержать только английский текст (кириллица и другие алфавиты запрещены)"
-	diagNoSpecials  = "лог-сообщение не должно содержать спецсимволы (!, ?, ...) и эмодзи"
-	diagSensitive   = "лог-сообщение содержит потенциально чувствительные данные"
-)
+const AnalyzerName = "logmsglint"
 
 const sensitiveReplacement = "[redacted]"
 
+// defaultSecretTag помечает поля структур, которые нельзя писать в лог.
+const defaultSecretTag = `log:"-"`
+
+// Сентинел-ошибки конфигурации. Текст выводится на языке из language,
+// но errors.Is сравнивает их независимо от языка.
 var (
-	ErrInvalidConfigType      = errors.New("неверный тип конфигурации")
-	ErrInvalidSensitiveRegex  = errors.New("невалидный паттерн чувствительных данных")
-	ErrExpectedStringSlice    = errors.New("ожидался список строк")
-	ErrExpectedStringListItem = errors.New("элемент списка не является строкой")
+	ErrInvalidConfigType      = newError(errInvalidConfigType)
+	ErrInvalidSensitiveRegex  = newError(errInvalidSensitiveRegex)
+	ErrExpectedStringSlice    = newError(errExpectedStringSlice)
+	ErrExpectedStringListItem = newError(errExpectedStringListItem)
+	ErrExpectedSinkMap        = newError(errExpectedSinkMap)
+	ErrInvalidSinkName        = newError(errInvalidSinkName)
+	ErrInvalidSinkIndex       = newError(errInvalidSinkIndex)
+	ErrInvalidSecretTag       = newError(errInvalidSecretTag)
+	ErrExpectedBool           = newError(errExpectedBool)
+	ErrExpectedString         = newError(errExpectedString)
+	ErrExpectedNumber         = newError(errExpectedNumber)
+	ErrInvalidEntropy         = newError(errInvalidEntropy)
+	ErrUnknownRule            = newError(errUnknownRule)
+	ErrInvalidSeverity        = newError(errInvalidSeverity)
+	ErrUnknownLanguage        = newError(errUnknownLanguage)
+	ErrUnknownScript          = newError(errUnknownScript)
+	ErrInvalidPunctuation     = newError(errInvalidPunctuation)
+	ErrInvalidSensitiveAllow  = newError(errInvalidSensitiveAllow)
+	ErrUnknownSensitive       = newError(errUnknownSensitive)
+	ErrInvalidBaseline        = newError(errInvalidBaseline)
+
+	// Ошибки точек входа плагинов golangci-lint, см. WrapError.
+	ErrPluginConfig = newError(errPluginConfig)
+	ErrPluginInit   = newError(errPluginInit)
 )
 
 var defaultSensitivePatterns = []string{
@@ -56,6 +79,23 @@ var slogMessageIndexes = map[string]int{
 	"LogAttrs":     2,
 }
 
+// firstStringLiteralArg обозначает Print/Println-подобные вызовы без фиксированной
+// позиции сообщения: сообщением считается первый аргумент со строковым литералом.
+const firstStringLiteralArg = -1
+
+var stdlogMessageIndexes = map[string]int{
+	"Print":   firstStringLiteralArg,
+	"Println": firstStringLiteralArg,
+	"Fatal":   firstStringLiteralArg,
+	"Fatalln": firstStringLiteralArg,
+	"Panic":   firstStringLiteralArg,
+	"Panicln": firstStringLiteralArg,
+	"Printf":  0,
+	"Fatalf":  0,
+	"Panicf":  0,
+	"Output":  1,
+}
+
 var zapMessageFirstMethods = map[string]struct{}{
 	"Debug":   {},
 	"Info":    {},
@@ -80,13 +120,184 @@ var zapMessageFirstMethods = map[string]struct{}{
 	"Fatalw":  {},
 }
 
+// zerologEventMessageIndexes описывает методы *zerolog.Event, завершающие цепочку
+// вида log.Info().Str("k", v).Msg("text"). Send сообщения не принимает, поэтому
+// проверять в нем нечего.
+var zerologEventMessageIndexes = map[string]int{
+	"Msg":  0,
+	"Msgf": 0,
+}
+
+// zerologPrintMessageIndexes описывает Print-методы zerolog.Logger и одноименные
+// функции пакета github.com/rs/zerolog/log.
+var zerologPrintMessageIndexes = map[string]int{
+	"Print":  firstStringLiteralArg,
+	"Printf": 0,
+}
+
+// logrusLevels перечисляет уровни logrus. Для каждого уровня есть три формы:
+// Info(args...), Infof(format, args...) и Infoln(args...).
+var logrusLevels = []string{"Trace", "Debug", "Info", "Print", "Warn", "Warning", "Error", "Fatal", "Panic"}
+
+var logrusMessageIndexes = newLogrusMessageIndexes()
+
+// logrusReceivers перечисляет типы logrus, методы которых пишут в лог.
+// FieldLogger и Ext1FieldLogger — интерфейсы, их методы приходят из Selections.
+var logrusReceivers = map[string]struct{}{
+	"":                {},
+	"Logger":          {},
+	"Entry":           {},
+	"FieldLogger":     {},
+	"Ext1FieldLogger": {},
+}
+
 // Config описывает пользовательскую конфигурацию анализатора.
 type Config struct {
 	SensitivePatterns []string `json:"sensitive-patterns" yaml:"sensitive-patterns" mapstructure:"sensitive-patterns"`
+	// SensitiveAllowPatterns — исключения для чувствительных паттернов: совпадение
+	// не считается нарушением, если его целиком покрывает совпадение исключения.
+	SensitiveAllowPatterns []SensitiveAllowConfig `json:"sensitive-allow-patterns" yaml:"sensitive-allow-patterns" mapstructure:"sensitive-allow-patterns"`
+	// ExtraSinks задает собственные функции логирования в виде
+	// "путь/пакета.Функция" или "путь/пакета.Тип.Метод" -> индекс аргумента сообщения.
+	ExtraSinks map[string]int `json:"extra-sinks" yaml:"extra-sinks" mapstructure:"extra-sinks"`
+	// SecretTag — тег поля структуры, которым помечены секреты, например log:"-".
+	SecretTag string `json:"secret-tag" yaml:"secret-tag" mapstructure:"secret-tag"`
+	// Taint включает SSA-анализ потока секретов до логгеров (по умолчанию выключен).
+	Taint TaintConfig `json:"taint" yaml:"taint" mapstructure:"taint"`
+	// Entropy настраивает поиск в сообщениях длинных токенов с высокой энтропией.
+	Entropy EntropyConfig `json:"entropy" yaml:"entropy" mapstructure:"entropy"`
+	// PII включает поиск персональных данных в тексте сообщений.
+	PII PIIConfig `json:"pii" yaml:"pii" mapstructure:"pii"`
+	// Rules включает, выключает и задает уровень правил по их идентификаторам:
+	// lowercase, english-only, no-specials, sensitive и т.д.
+	Rules map[string]RuleConfig `json:"rules" yaml:"rules" mapstructure:"rules"`
+	// AllowedScripts — письменности Unicode, буквы которых допустимы в сообщениях
+	// (имена из unicode.Scripts: Latin, Greek, Cyrillic). По умолчанию только Latin.
+	AllowedScripts []string `json:"allowed-scripts" yaml:"allowed-scripts" mapstructure:"allowed-scripts"`
+	// AllowedRunes — отдельные разрешенные буквы, например "µ" или "°".
+	AllowedRunes []string `json:"allowed-runes" yaml:"allowed-runes" mapstructure:"allowed-runes"`
+	// ASCIIOnly запрещает любые не-ASCII буквы (é, ß) и комбинирующие знаки, кроме AllowedRunes.
+	ASCIIOnly bool `json:"ascii-only" yaml:"ascii-only" mapstructure:"ascii-only"`
+	// Punctuation настраивает правило no-specials: запрещенные знаки и проверки пробелов.
+	Punctuation PunctuationConfig `json:"punctuation" yaml:"punctuation" mapstructure:"punctuation"`
+	// ReportUnusedDirectives сообщает о директивах //logmsglint:ignore,
+	// которые ничего не подавляют.
+	ReportUnusedDirectives bool `json:"report-unused-directives" yaml:"report-unused-directives" mapstructure:"report-unused-directives"`
+	// Baseline — путь к файлу baseline (относительно рабочего каталога): находки
+	// из него не попадают в отчет. Файл создает команда logmsglint-baseline.
+	Baseline string `json:"baseline" yaml:"baseline" mapstructure:"baseline"`
+	// Language — язык диагностик и ошибок конфигурации: en (по умолчанию) или ru.
+	Language string `json:"language" yaml:"language" mapstructure:"language"`
+}
+
+// SensitiveAllowConfig — исключение для чувствительных паттернов. Allow — регулярные
+// выражения или фразы без метасимволов (фразы ищутся без учета регистра).
+// Pattern — паттерн в том же написании, что в sensitive-patterns или в списке
+// по умолчанию (например `(?i)\btoken\b`). Пустой Pattern означает, что
+// исключение действует для всех паттернов.
+type SensitiveAllowConfig struct {
+	Pattern string   `json:"pattern" yaml:"pattern" mapstructure:"pattern"`
+	Allow   []string `json:"allow" yaml:"allow" mapstructure:"allow"`
+}
+
+// UnmarshalJSON принимает, как и ParseConfig, строку — глобальное исключение —
+// или объект {pattern, allow}. Неизвестные ключи объекта отклоняются.
+func (c *SensitiveAllowConfig) UnmarshalJSON(data []byte) error {
+	var allow string
+	if err := json.Unmarshal(data, &allow); err == nil {
+		*c = SensitiveAllowConfig{Allow: []string{allow}}
+		return nil
+	}
+
+	type plain SensitiveAllowConfig
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode((*plain)(c))
+}
+
+// RuleConfig настраивает одно правило. Enabled == nil означает, что правило
+// включено; Severity — error (по умолчанию), warning или info.
+type RuleConfig struct {
+	Enabled  *bool  `json:"enabled" yaml:"enabled" mapstructure:"enabled"`
+	Severity string `json:"severity" yaml:"severity" mapstructure:"severity"`
+}
+
+// TaintConfig настраивает taint-анализ.
+type TaintConfig struct {
+	Enabled bool `json:"enabled" yaml:"enabled" mapstructure:"enabled"`
+	// Sources — дополнительные функции, результат которых всегда считается секретом,
+	// в том же формате, что и extra-sinks: "путь/пакета.Тип.Метод".
+	Sources []string `json:"sources" yaml:"sources" mapstructure:"sources"`
+}
+
+// EntropyConfig задает пороги эвристики энтропии. Нулевые значения означают
+// значения по умолчанию: 4.0 бита на символ и 20 символов.
+type EntropyConfig struct {
+	Threshold float64 `json:"threshold" yaml:"threshold" mapstructure:"threshold"`
+	MinLength int     `json:"min-length" yaml:"min-length" mapstructure:"min-length"`
+}
+
+// PIIConfig включает детекторы персональных данных по отдельности (по умолчанию
+// все выключены). Allow — домены, адреса, подсети или точные значения, которые
+// разрешены в дополнение к документационным (example.com, 192.0.2.0/24 и др.).
+type PIIConfig struct {
+	Email bool     `json:"email" yaml:"email" mapstructure:"email"`
+	IP    bool     `json:"ip" yaml:"ip" mapstructure:"ip"`
+	Phone bool     `json:"phone" yaml:"phone" mapstructure:"phone"`
+	Card  bool     `json:"card" yaml:"card" mapstructure:"card"`
+	Allow []string `json:"allow" yaml:"allow" mapstructure:"allow"`
+}
+
+// PunctuationConfig задает знаки и пробельные символы, запрещенные в сообщениях.
+// Эмодзи запрещены всегда, пока включено правило no-specials.
+type PunctuationConfig struct {
+	// Forbidden — запрещенные символы и последовательности. Пустой список
+	// означает значения по умолчанию: "!", "?", "..." и "…".
+	Forbidden []string `json:"forbidden" yaml:"forbidden" mapstructure:"forbidden"`
+	// TrailingPeriod запрещает точку в конце сообщения.
+	TrailingPeriod bool `json:"trailing-period" yaml:"trailing-period" mapstructure:"trailing-period"`
+	// DoubleSpaces запрещает несколько пробелов подряд.
+	DoubleSpaces bool `json:"double-spaces" yaml:"double-spaces" mapstructure:"double-spaces"`
+	// Tabs запрещает табуляции.
+	Tabs bool `json:"tabs" yaml:"tabs" mapstructure:"tabs"`
+	// Newlines запрещает переводы строк внутри сообщения; завершающий \n допустим.
+	Newlines bool `json:"newlines" yaml:"newlines" mapstructure:"newlines"`
 }
 
 type sensitivePattern struct {
 	re *regexp.Regexp
+	// allow — исключения этого паттерна вместе с глобальными.
+	allow []*regexp.Regexp
+}
+
+// sinkKey однозначно задает функцию логирования: пакет, тип получателя
+// (пусто для функций пакета) и имя.
+type sinkKey struct {
+	pkgPath string
+	recv    string
+	name    string
+}
+
+// settings — скомпилированная конфигурация, с которой работает run.
+type settings struct {
+	patterns     []sensitivePattern
+	extraSinks   map[sinkKey]int
+	secretTag    structTag
+	taint        bool
+	taintSources map[sinkKey]struct{}
+	entropy      entropySettings
+	pii          piiSettings
+	rules        map[string]ruleSettings
+	msgs         catalog
+	language     string
+	letters      letterPolicy
+	punctuation  punctuationPolicy
+	reportUnused bool
+	baseline     *baselineSettings
+	// directives и baselinePass — состояние текущего пакета; заполняются
+	// в копии настроек на время одного прохода.
+	directives   []*directive
+	baselinePass *baselinePass
 }
 
 // Analyzer можно использовать в unit-тестах и при прямом запуске анализатора.
@@ -94,18 +305,112 @@ var Analyzer = newDefaultAnalyzer()
 
 // NewAnalyzer создает анализатор с учетом пользовательских паттернов чувствительных данных.
 func NewAnalyzer(cfg Config) (*analysis.Analyzer, error) {
-	patterns, err := compileSensitivePatterns(cfg.SensitivePatterns)
+	msgs, err := lookupCatalog(cfg.Language)
 	if err != nil {
 		return nil, err
 	}
 
+	a, err := newAnalyzer(cfg, msgs)
+	if err != nil {
+		return nil, localizeError(err, cfg.Language)
+	}
+	return a, nil
+}
+
+func newAnalyzer(cfg Config, msgs catalog) (*analysis.Analyzer, error) {
+	patterns, err := compileSensitivePatterns(cfg.SensitivePatterns, cfg.SensitiveAllowPatterns)
+	if err != nil {
+		return nil, err
+	}
+
+	extraSinks, err := compileExtraSinks(cfg.ExtraSinks)
+	if err != nil {
+		return nil, err
+	}
+
+	secretTag, err := parseStructTag(cmp.Or(cfg.SecretTag, defaultSecretTag))
+	if err != nil {
+		return nil, err
+	}
+
+	taintSources := make(map[sinkKey]struct{}, len(cfg.Taint.Sources))
+	for _, source := range cfg.Taint.Sources {
+		key, err := parseSinkName(source)
+		if err != nil {
+			return nil, err
+		}
+		taintSources[key] = struct{}{}
+	}
+
+	entropy, err := compileEntropy(cfg.Entropy)
+	if err != nil {
+		return nil, err
+	}
+
+	rules, err := compileRules(cfg.Rules)
+	if err != nil {
+		return nil, err
+	}
+
+	letters, err := compileLetterPolicy(cfg.AllowedScripts, cfg.AllowedRunes, cfg.ASCIIOnly)
+	if err != nil {
+		return nil, err
+	}
+
+	punctuation, err := compilePunctuation(cfg.Punctuation)
+	if err != nil {
+		return nil, err
+	}
+
+	baseline, err := loadBaseline(cfg.Baseline)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &settings{
+		patterns:     patterns,
+		extraSinks:   extraSinks,
+		secretTag:    secretTag,
+		taint:        cfg.Taint.Enabled,
+		taintSources: taintSources,
+		entropy:      entropy,
+		pii:          compilePII(cfg.PII),
+		rules:        rules,
+		msgs:         msgs,
+		language:     cfg.Language,
+		letters:      letters,
+		punctuation:  punctuation,
+		reportUnused: cfg.ReportUnusedDirectives,
+		baseline:     baseline,
+	}
+
 	analyzer := &analysis.Analyzer{
 		Name: AnalyzerName,
-		Doc:  "проверяет текст лог-сообщений в slog и zap",
+		Doc:  msgs.text(msgAnalyzerDoc),
 		Run: func(pass *analysis.Pass) (any, error) {
-			run(pass, patterns)
+			// Анализатор общий для всех пакетов, а директивы и записи baseline
+			// у каждого пакета свои, поэтому проход работает с копией настроек.
+			ps := *s
+			ps.directives = collectDirectives(pass, &ps)
+			ps.baselinePass = s.baseline.forPass(pass)
+
+			run(pass, &ps)
+			if ps.taint {
+				runTaint(pass, &ps)
+			}
+			if ps.reportUnused {
+				ps.reportUnusedDirectives(pass)
+			}
+			ps.reportFixed(pass)
 			return nil, nil
 		},
+		FactTypes: []analysis.Fact{new(messageSinkFact)},
+	}
+
+	// SSA строим только при включенном taint-анализе: это заметно дороже AST-проверок.
+	s.taint = s.taint && s.ruleEnabled(ruleTaint)
+	if s.taint {
+		analyzer.Requires = []*analysis.Analyzer{buildssa.Analyzer}
 	}
 
 	return analyzer, nil
@@ -127,27 +432,407 @@ func ParseConfig(raw any) (Config, error) {
 
 	m, ok := normalizeMap(raw)
 	if !ok {
-		return Config{}, fmt.Errorf("%w: ожидалась map-конфигурация, получено %T", ErrInvalidConfigType, raw)
+		return Config{}, newError(errfExpectedMap, ErrInvalidConfigType, raw)
+	}
+
+	// Язык разбираем первым: на нем выводятся ошибки остальных ключей.
+	language := ""
+	if value, key, exists := lookupConfigKey(m, "language"); exists {
+		lang, ok := value.(string)
+		if !ok {
+			return Config{}, newError(errfKeyGotType, key, ErrExpectedString, value)
+		}
+		if _, err := lookupCatalog(lang); err != nil {
+			return Config{}, newError(errfKey, key, err)
+		}
+		language = lang
+	}
+
+	cfg, err := parseConfig(m)
+	if err != nil {
+		return Config{}, localizeError(err, language)
 	}
+	cfg.Language = language
 
+	return cfg, nil
+}
+
+func parseConfig(m map[string]any) (Config, error) {
 	cfg := Config{}
-	for _, key := range []string{"sensitive-patterns", "sensitive_patterns", "sensitivePatterns"} {
-		value, exists := m[key]
+	if value, key, exists := lookupConfigKey(m, "sensitive-patterns"); exists {
+		patterns, err := toStringSlice(value)
+		if err != nil {
+			return Config{}, newError(errfKey, key, err)
+		}
+		cfg.SensitivePatterns = patterns
+	}
+
+	if value, key, exists := lookupConfigKey(m, "sensitive-allow-patterns"); exists {
+		allow, err := parseSensitiveAllowConfig(value)
+		if err != nil {
+			return Config{}, newError(errfKey, key, err)
+		}
+		if _, err := compileSensitivePatterns(cfg.SensitivePatterns, allow); err != nil {
+			return Config{}, newError(errfKey, key, err)
+		}
+		cfg.SensitiveAllowPatterns = allow
+	}
+
+	if value, key, exists := lookupConfigKey(m, "extra-sinks"); exists {
+		sinks, err := toSinkMap(value)
+		if err != nil {
+			return Config{}, newError(errfKey, key, err)
+		}
+		if _, err := compileExtraSinks(sinks); err != nil {
+			return Config{}, newError(errfKey, key, err)
+		}
+		cfg.ExtraSinks = sinks
+	}
+
+	if value, key, exists := lookupConfigKey(m, "secret-tag"); exists {
+		tag, ok := value.(string)
+		if !ok {
+			return Config{}, newError(errfKeyGotType, key, ErrExpectedString, value)
+		}
+		if _, err := parseStructTag(tag); err != nil {
+			return Config{}, newError(errfKey, key, err)
+		}
+		cfg.SecretTag = tag
+	}
+
+	if value, key, exists := lookupConfigKey(m, "taint"); exists {
+		taint, err := parseTaintConfig(value)
+		if err != nil {
+			return Config{}, newError(errfKey, key, err)
+		}
+		cfg.Taint = taint
+	}
+
+	if value, key, exists := lookupConfigKey(m, "entropy"); exists {
+		entropy, err := parseEntropyConfig(value)
+		if err != nil {
+			return Config{}, newError(errfKey, key, err)
+		}
+		cfg.Entropy = entropy
+	}
+
+	if value, key, exists := lookupConfigKey(m, "pii"); exists {
+		pii, err := parsePIIConfig(value)
+		if err != nil {
+			return Config{}, newError(errfKey, key, err)
+		}
+		cfg.PII = pii
+	}
+
+	if value, key, exists := lookupConfigKey(m, "allowed-scripts"); exists {
+		scripts, err := toStringSlice(value)
+		if err != nil {
+			return Config{}, newError(errfKey, key, err)
+		}
+		if _, err := compileLetterPolicy(scripts, nil, false); err != nil {
+			return Config{}, newError(errfKey, key, err)
+		}
+		cfg.AllowedScripts = scripts
+	}
+
+	if value, key, exists := lookupConfigKey(m, "allowed-runes"); exists {
+		runes, err := toStringSlice(value)
+		if err != nil {
+			return Config{}, newError(errfKey, key, err)
+		}
+		cfg.AllowedRunes = runes
+	}
+
+	if value, key, exists := lookupConfigKey(m, "ascii-only"); exists {
+		asciiOnly, ok := value.(bool)
+		if !ok {
+			return Config{}, newError(errfKeyGotType, key, ErrExpectedBool, value)
+		}
+		cfg.ASCIIOnly = asciiOnly
+	}
+
+	if value, key, exists := lookupConfigKey(m, "punctuation"); exists {
+		punctuation, err := parsePunctuationConfig(value)
+		if err != nil {
+			return Config{}, newError(errfKey, key, err)
+		}
+		cfg.Punctuation = punctuation
+	}
+
+	if value, key, exists := lookupConfigKey(m, "report-unused-directives"); exists {
+		report, ok := value.(bool)
+		if !ok {
+			return Config{}, newError(errfKeyGotType, key, ErrExpectedBool, value)
+		}
+		cfg.ReportUnusedDirectives = report
+	}
+
+	if value, key, exists := lookupConfigKey(m, "baseline"); exists {
+		baseline, ok := value.(string)
+		if !ok {
+			return Config{}, newError(errfKeyGotType, key, ErrExpectedString, value)
+		}
+		cfg.Baseline = baseline
+	}
+
+	if value, key, exists := lookupConfigKey(m, "rules"); exists {
+		rules, err := parseRulesConfig(value)
+		if err != nil {
+			return Config{}, newError(errfKey, key, err)
+		}
+		cfg.Rules = rules
+	}
+
+	return cfg, nil
+}
+
+// parseSensitiveAllowConfig принимает список, в котором строка — глобальное
+// исключение, а map вида {pattern: ..., allow: [...]} — исключения одного паттерна.
+func parseSensitiveAllowConfig(raw any) ([]SensitiveAllowConfig, error) {
+	items, ok := raw.([]any)
+	if !ok {
+		items = []any{raw}
+	}
+
+	result := make([]SensitiveAllowConfig, 0, len(items))
+	for _, item := range items {
+		entry, err := parseSensitiveAllowItem(item)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, entry)
+	}
+	return result, nil
+}
+
+func parseSensitiveAllowItem(raw any) (SensitiveAllowConfig, error) {
+	m, ok := normalizeMap(raw)
+	if !ok {
+		allow, err := toStringSlice(raw)
+		if err != nil {
+			return SensitiveAllowConfig{}, err
+		}
+		return SensitiveAllowConfig{Allow: allow}, nil
+	}
+
+	entry := SensitiveAllowConfig{}
+	if value, key, exists := lookupConfigKey(m, "pattern"); exists {
+		pattern, ok := value.(string)
+		if !ok {
+			return SensitiveAllowConfig{}, newError(errfKeyGotType, key, ErrExpectedString, value)
+		}
+		entry.Pattern = pattern
+	}
+	if value, key, exists := lookupConfigKey(m, "allow"); exists {
+		allow, err := toStringSlice(value)
+		if err != nil {
+			return SensitiveAllowConfig{}, newError(errfKey, key, err)
+		}
+		entry.Allow = allow
+	}
+	return entry, nil
+}
+
+func parseTaintConfig(raw any) (TaintConfig, error) {
+	m, ok := normalizeMap(raw)
+	if !ok {
+		return TaintConfig{}, newError(errfExpectedMap, ErrInvalidConfigType, raw)
+	}
+
+	cfg := TaintConfig{}
+	if value, key, exists := lookupConfigKey(m, "enabled"); exists {
+		enabled, ok := value.(bool)
+		if !ok {
+			return TaintConfig{}, newError(errfKeyGotType, key, ErrExpectedBool, value)
+		}
+		cfg.Enabled = enabled
+	}
+
+	if value, key, exists := lookupConfigKey(m, "sources"); exists {
+		sources, err := toStringSlice(value)
+		if err != nil {
+			return TaintConfig{}, newError(errfKey, key, err)
+		}
+		for _, source := range sources {
+			if _, err := parseSinkName(source); err != nil {
+				return TaintConfig{}, newError(errfKey, key, err)
+			}
+		}
+		cfg.Sources = sources
+	}
+
+	return cfg, nil
+}
+
+func parseEntropyConfig(raw any) (EntropyConfig, error) {
+	m, ok := normalizeMap(raw)
+	if !ok {
+		return EntropyConfig{}, newError(errfExpectedMap, ErrInvalidConfigType, raw)
+	}
+
+	cfg := EntropyConfig{}
+	if value, key, exists := lookupConfigKey(m, "threshold"); exists {
+		threshold, ok := toFloat(value)
+		if !ok {
+			return EntropyConfig{}, newError(errfKeyGotType, key, ErrExpectedNumber, value)
+		}
+		cfg.Threshold = threshold
+	}
+
+	if value, key, exists := lookupConfigKey(m, "min-length"); exists {
+		minLength, ok := toInt(value)
+		if !ok {
+			return EntropyConfig{}, newError(errfKeyGotValue, key, ErrExpectedNumber, value)
+		}
+		cfg.MinLength = minLength
+	}
+
+	if _, err := compileEntropy(cfg); err != nil {
+		return EntropyConfig{}, err
+	}
+
+	return cfg, nil
+}
+
+func parsePIIConfig(raw any) (PIIConfig, error) {
+	m, ok := normalizeMap(raw)
+	if !ok {
+		return PIIConfig{}, newError(errfExpectedMap, ErrInvalidConfigType, raw)
+	}
+
+	cfg := PIIConfig{}
+	for _, detector := range []struct {
+		key   string
+		value *bool
+	}{
+		{key: "email", value: &cfg.Email},
+		{key: "ip", value: &cfg.IP},
+		{key: "phone", value: &cfg.Phone},
+		{key: "card", value: &cfg.Card},
+	} {
+		value, key, exists := lookupConfigKey(m, detector.key)
 		if !exists {
 			continue
 		}
+		enabled, ok := value.(bool)
+		if !ok {
+			return PIIConfig{}, newError(errfKeyGotType, key, ErrExpectedBool, value)
+		}
+		*detector.value = enabled
+	}
 
-		patterns, err := toStringSlice(value)
+	if value, key, exists := lookupConfigKey(m, "allow"); exists {
+		allow, err := toStringSlice(value)
 		if err != nil {
-			return Config{}, fmt.Errorf("ключ %q: %w", key, err)
+			return PIIConfig{}, newError(errfKey, key, err)
 		}
-		cfg.SensitivePatterns = patterns
-		break
+		cfg.Allow = allow
+	}
+
+	return cfg, nil
+}
+
+func parsePunctuationConfig(raw any) (PunctuationConfig, error) {
+	m, ok := normalizeMap(raw)
+	if !ok {
+		return PunctuationConfig{}, newError(errfExpectedMap, ErrInvalidConfigType, raw)
+	}
+
+	cfg := PunctuationConfig{}
+	if value, key, exists := lookupConfigKey(m, "forbidden"); exists {
+		forbidden, err := toStringSlice(value)
+		if err != nil {
+			return PunctuationConfig{}, newError(errfKey, key, err)
+		}
+		cfg.Forbidden = forbidden
+	}
+
+	for _, option := range []struct {
+		key   string
+		value *bool
+	}{
+		{key: "trailing-period", value: &cfg.TrailingPeriod},
+		{key: "double-spaces", value: &cfg.DoubleSpaces},
+		{key: "tabs", value: &cfg.Tabs},
+		{key: "newlines", value: &cfg.Newlines},
+	} {
+		value, key, exists := lookupConfigKey(m, option.key)
+		if !exists {
+			continue
+		}
+		enabled, ok := value.(bool)
+		if !ok {
+			return PunctuationConfig{}, newError(errfKeyGotType, key, ErrExpectedBool, value)
+		}
+		*option.value = enabled
+	}
+
+	if _, err := compilePunctuation(cfg); err != nil {
+		return PunctuationConfig{}, err
 	}
 
 	return cfg, nil
 }
 
+func parseRulesConfig(raw any) (map[string]RuleConfig, error) {
+	m, ok := normalizeMap(raw)
+	if !ok {
+		return nil, newError(errfExpectedMap, ErrInvalidConfigType, raw)
+	}
+
+	rules := make(map[string]RuleConfig, len(m))
+	for id, value := range m {
+		ruleMap, ok := normalizeMap(value)
+		if !ok {
+			return nil, newError(errfRuleExpectedMap, id, ErrInvalidConfigType, value)
+		}
+
+		rule := RuleConfig{}
+		if value, key, exists := lookupConfigKey(ruleMap, "enabled"); exists {
+			enabled, ok := value.(bool)
+			if !ok {
+				return nil, newError(errfRuleKeyGotType, id, key, ErrExpectedBool, value)
+			}
+			rule.Enabled = &enabled
+		}
+		if value, key, exists := lookupConfigKey(ruleMap, "severity"); exists {
+			severity, ok := value.(string)
+			if !ok {
+				return nil, newError(errfRuleKeyGotType, id, key, ErrExpectedString, value)
+			}
+			rule.Severity = severity
+		}
+		rules[id] = rule
+	}
+
+	if _, err := compileRules(rules); err != nil {
+		return nil, err
+	}
+
+	return rules, nil
+}
+
+// lookupConfigKey ищет ключ в kebab-case, snake_case и camelCase написании:
+// разные версии golangci-lint и ручные конфиги передают ключи по-разному.
+func lookupConfigKey(m map[string]any, kebab string) (any, string, bool) {
+	parts := strings.Split(kebab, "-")
+	camel := parts[0]
+	for _, part := range parts[1:] {
+		if part == "" {
+			continue
+		}
+		camel += strings.ToUpper(part[:1]) + part[1:]
+	}
+
+	for _, key := range []string{kebab, strings.ReplaceAll(kebab, "-", "_"), camel} {
+		if value, exists := m[key]; exists {
+			return value, key, true
+		}
+	}
+
+	return nil, "", false
+}
+
 // newDefaultAnalyzer гарантирует, что пакет не упадет на этапе импорта.
 // Даже если дефолтная конфигурация по ошибке сломана, мы возвращаем анализатор,
 // который сообщает диагностическую ошибку в рантайме.
@@ -159,14 +844,14 @@ func newDefaultAnalyzer() *analysis.Analyzer {
 
 	return &analysis.Analyzer{
 		Name: AnalyzerName,
-		Doc:  "проверяет текст лог-сообщений в slog и zap",
+		Doc:  catalogs[defaultLanguage].text(msgAnalyzerDoc),
 		Run: func(pass *analysis.Pass) (any, error) {
-			return nil, fmt.Errorf("внутренняя ошибка инициализации анализатора: %w", err)
+			return nil, newError(errfInit, err)
 		},
 	}
 }
 
-func compileSensitivePatterns(custom []string) ([]sensitivePattern, error) {
+func compileSensitivePatterns(custom []string, allow []SensitiveAllowConfig) ([]sensitivePattern, error) {
 	merged := make([]string, 0, len(defaultSensitivePatterns)+len(custom))
 	merged = append(merged, defaultSensitivePatterns...)
 	merged = append(merged, custom...)
@@ -174,27 +859,144 @@ func compileSensitivePatterns(custom []string) ([]sensitivePattern, error) {
 	seen := make(map[string]struct{}, len(merged))
 	patterns := make([]sensitivePattern, 0, len(merged))
 
-	for _, raw := range merged {
-		pattern := strings.TrimSpace(raw)
-		if pattern == "" {
-			continue
+	for _, raw := range merged {
+		pattern := strings.TrimSpace(raw)
+		if pattern == "" {
+			continue
+		}
+		if _, exists := seen[pattern]; exists {
+			continue
+		}
+		seen[pattern] = struct{}{}
+
+		re, err := regexp.Compile(pattern)
+		if err != nil {
+			return nil, newError(errfInvalidRegex, ErrInvalidSensitiveRegex, pattern, err)
+		}
+		patterns = append(patterns, sensitivePattern{re: re})
+	}
+
+	for _, entry := range allow {
+		target := strings.TrimSpace(entry.Pattern)
+		idx := -1
+		if target != "" {
+			idx = slices.IndexFunc(patterns, func(p sensitivePattern) bool { return p.re.String() == target })
+			if idx < 0 {
+				return nil, newError(errfQuoted, ErrUnknownSensitive, target)
+			}
+		}
+
+		for _, raw := range entry.Allow {
+			re, err := compileSensitiveAllow(raw)
+			if err != nil {
+				return nil, newError(errfInvalidRegex, ErrInvalidSensitiveAllow, raw, err)
+			}
+			if idx >= 0 {
+				patterns[idx].allow = append(patterns[idx].allow, re)
+				continue
+			}
+			for i := range patterns {
+				patterns[i].allow = append(patterns[i].allow, re)
+			}
+		}
+	}
+
+	return patterns, nil
+}
+
+// compileSensitiveAllow компилирует исключение. Фраза без метасимволов регулярных
+// выражений ищется без учета регистра, как и встроенные паттерны: иначе "token bucket"
+// не покрыло бы "Token bucket". Остальные записи — регулярные выражения как есть.
+func compileSensitiveAllow(raw string) (*regexp.Regexp, error) {
+	raw = strings.TrimSpace(raw)
+	if regexp.QuoteMeta(raw) == raw {
+		return regexp.Compile("(?i)" + raw)
+	}
+	return regexp.Compile(raw)
+}
+
+// compileEntropy подставляет значения по умолчанию и проверяет пороги.
+// Энтропия строки не превышает log2 от размера алфавита (6 бит для base64),
+// поэтому порог больше 8 бит на символ не сработает никогда.
+func compileEntropy(cfg EntropyConfig) (entropySettings, error) {
+	if cfg.Threshold < 0 || cfg.Threshold > 8 || math.IsNaN(cfg.Threshold) || cfg.MinLength < 0 {
+		return entropySettings{}, newError(errfInvalidEntropy, ErrInvalidEntropy, cfg.Threshold, cfg.MinLength)
+	}
+
+	entropy := entropySettings{threshold: cfg.Threshold, minLength: cfg.MinLength}
+	if entropy.threshold == 0 {
+		entropy.threshold = defaultEntropyThreshold
+	}
+	if entropy.minLength == 0 {
+		entropy.minLength = defaultEntropyMinLength
+	}
+	return entropy, nil
+}
+
+// compileExtraSinks разбирает пользовательские функции логирования.
+// Имя пакета может содержать точки (github.com/...), поэтому разбираем
+// только последний сегмент пути: "obs.Logger.Info" или "obs.Infof".
+func compileExtraSinks(raw map[string]int) (map[sinkKey]int, error) {
+	sinks := make(map[sinkKey]int, len(raw))
+	for name, idx := range raw {
+		key, err := parseSinkName(name)
+		if err != nil {
+			return nil, err
 		}
-		if _, exists := seen[pattern]; exists {
-			continue
+		if idx < 0 {
+			return nil, newError(errfQuotedValue, ErrInvalidSinkIndex, name, idx)
 		}
-		seen[pattern] = struct{}{}
+		sinks[key] = idx
+	}
+	return sinks, nil
+}
 
-		re, err := regexp.Compile(pattern)
-		if err != nil {
-			return nil, fmt.Errorf("%w: %q", errors.Join(ErrInvalidSensitiveRegex, err), pattern)
+func parseSinkName(raw string) (sinkKey, error) {
+	name := strings.TrimSpace(raw)
+
+	dot := strings.LastIndex(name, ".")
+	if dot <= 0 || dot <= strings.LastIndex(name, "/") {
+		return sinkKey{}, newError(errfQuoted, ErrInvalidSinkName, raw)
+	}
+
+	key := sinkKey{pkgPath: name[:dot], name: name[dot+1:]}
+	if !token.IsIdentifier(key.name) {
+		return sinkKey{}, newError(errfQuoted, ErrInvalidSinkName, raw)
+	}
+
+	// Последний сегмент пути пакета может содержать точки (gopkg.in/yaml.v3),
+	// поэтому получателем считаем только экспортируемый идентификатор
+	// или явную запись вида (*Logger) / (Logger).
+	rest := key.pkgPath
+	if dot := strings.LastIndex(rest, "."); dot > strings.LastIndex(rest, "/") {
+		recv := rest[dot+1:]
+		explicit := strings.HasPrefix(recv, "(") && strings.HasSuffix(recv, ")")
+		if explicit {
+			recv = strings.TrimPrefix(strings.TrimSuffix(recv[1:], ")"), "*")
+		}
+		if explicit || (token.IsIdentifier(recv) && token.IsExported(recv)) {
+			if !token.IsIdentifier(recv) || dot == 0 {
+				return sinkKey{}, newError(errfQuoted, ErrInvalidSinkName, raw)
+			}
+			key.pkgPath, key.recv = rest[:dot], recv
 		}
-		patterns = append(patterns, sensitivePattern{re: re})
 	}
 
-	return patterns, nil
+	return key, nil
 }
 
-func run(pass *analysis.Pass, patterns []sensitivePattern) {
+func run(pass *analysis.Pass, cfg *settings) {
+	values := collectFuncValues(pass)
+	constLits := collectConstLiterals(pass)
+	// Константу могут логировать в нескольких местах. Правка ее объявления
+	// предлагается один раз на правило, иначе под -fix одинаковые правки
+	// одного литерала конфликтуют.
+	constFixes := make(map[constFix]struct{})
+
+	// Сначала выводим обертки текущего пакета, чтобы вызовы оберток
+	// ниже по файлу проверялись так же, как вызовы самих логгеров.
+	inferWrappers(pass, cfg, values)
+
 	for _, file := range pass.Files {
 		ast.Inspect(file, func(node ast.Node) bool {
 			call, ok := node.(*ast.CallExpr)
@@ -202,47 +1004,81 @@ func run(pass *analysis.Pass, patterns []sensitivePattern) {
 				return true
 			}
 
-			msgExpr, ok := extractMessageExpr(pass, call)
+			checkAttributeKeys(pass, cfg, values, call)
+			checkSensitiveArgs(pass, cfg, values, call)
+			checkSecretStructs(pass, cfg, values, call)
+
+			msgExpr, ok := extractMessageExpr(pass, cfg, values, call)
 			if !ok {
 				return true
 			}
 
 			// Важный момент: сообщение может быть не только строковым литералом,
-			// но и выражением конкатенации вида "prefix" + variable.
+			// но и выражением конкатенации вида "prefix" + variable или константой.
 			// Поэтому вместо попытки вычислить одно итоговое значение мы
-			// извлекаем все буквальные строковые куски из AST.
-			literals := extractAllStringLiterals(msgExpr)
-			if len(literals) == 0 {
+			// извлекаем все известные на этапе компиляции строковые куски.
+			fragments := extractMessageFragments(pass.TypesInfo, msgExpr)
+			if len(fragments) == 0 {
 				return true
 			}
 
-			// Автофикс безопасен только для чистого строкового литерала.
-			// Если выражение сложнее (конкатенация и т.п.), не пытаемся
-			// переписывать его текстом, чтобы не сломать исходное выражение.
-			canFix := canRewriteMessageExpr(msgExpr)
+			// Автофикс безопасен, только если сообщение целиком задано одним
+			// литералом: либо прямо в вызове, либо в объявлении константы
+			// текущего пакета. Конкатенации переписывать не пытаемся,
+			// чтобы не сломать исходное выражение.
+			var fixLit *ast.BasicLit
+			if len(fragments) == 1 && fragments[0].node == stripParens(msgExpr) {
+				fixLit = rewritableLiteral(pass, constLits, fragments[0])
+			}
+
+			report := func(rule string, fragment messageFragment, message, fixed string) {
+				lit := fixLit
+				key := constFix{lit: fixLit, rule: rule}
+				if _, done := constFixes[key]; done {
+					lit = nil
+				}
+				if cfg.report(pass, rule, buildDiagnostic(cfg.msgs, msgExpr, fragment, message, fixed, lit)) && lit != nil && fragment.constObj != nil {
+					constFixes[key] = struct{}{}
+				}
+			}
+
+			for idx, fragment := range fragments {
+				literal := fragment.text
 
-			for idx, literal := range literals {
-				// Проверку регистра делаем только по первому строковому куску,
-				// чтобы не получать ложные срабатывания на последующих частях
-				// выражений конкатенации.
-				if idx == 0 {
+				// Проверку регистра делаем только по первому строковому куску
+				// и только если он действительно открывает сообщение: последующие
+				// части конкатенаций и аргументы Sprintf могут начинаться с чего угодно.
+				if idx == 0 && fragment.leading {
 					if violated, fixed := violatesLowercaseRule(literal); violated {
-						pass.Report(buildDiagnostic(msgExpr, diagStartLower, literal, fixed, canFix))
+						report(ruleLowercase, fragment, cfg.msgs.text(diagStartLower), fixed)
 					}
 				}
 
-				if containsNonEnglishLetters(literal) {
-					pass.Report(buildDiagnostic(msgExpr, diagEnglishOnly, literal, "", false))
+				if containsNonEnglishLetters(literal, cfg.letters) {
+					fixed, _ := transliterate(literal, cfg.letters)
+					report(ruleEnglishOnly, fragment, cfg.msgs.text(diagEnglishOnly), fixed)
+				}
+
+				if containsSpecialSymbolsOrEmoji(literal, cfg.punctuation, fragment.trailing) {
+					fixed := stripSpecialSymbolsAndEmoji(literal, cfg.punctuation, fragment.trailing)
+					report(ruleNoSpecials, fragment, cfg.msgs.text(diagNoSpecials), fixed)
 				}
 
-				if containsSpecialSymbolsOrEmoji(literal) {
-					fixed := stripSpecialSymbolsAndEmoji(literal)
-					pass.Report(buildDiagnostic(msgExpr, diagNoSpecials, literal, fixed, canFix))
+				if containsSensitiveData(literal, cfg.patterns) {
+					fixed := redactSensitiveData(literal, cfg.patterns)
+					report(ruleSensitive, fragment, cfg.msgs.text(diagSensitive), fixed)
 				}
 
-				if containsSensitiveData(literal, patterns) {
-					fixed := redactSensitiveData(literal, patterns)
-					pass.Report(buildDiagnostic(msgExpr, diagSensitive, literal, fixed, canFix))
+				if kind, found := findCredential(literal, cfg.entropy, cfg.msgs); found {
+					fixed := redactCredentials(literal, cfg.entropy)
+					report(ruleCredential, fragment, cfg.msgs.text(diagCredential)+": "+kind, fixed)
+				}
+
+				for _, detector := range cfg.pii.detectors {
+					if spans := detector.find(literal, cfg.pii.allow); len(spans) > 0 {
+						fixed := redactSpans(literal, spans)
+						report(rulePII, fragment, cfg.msgs.text(diagPII)+": "+cfg.msgs.text(detector.name), fixed)
+					}
 				}
 			}
 
@@ -251,21 +1087,38 @@ func run(pass *analysis.Pass, patterns []sensitivePattern) {
 	}
 }
 
+// callTarget описывает вызываемую функцию логгера и сдвиг аргументов относительно
+// ее сигнатуры: у method expression вида (*slog.Logger).Info(l, "msg")
+// получатель передается первым аргументом, поэтому сообщение смещается на один.
+type callTarget struct {
+	fn        *types.Func
+	argOffset int
+}
+
+// funcValues связывает локальные переменные с функциями логгера, которые
+// в них сохранены: f := logger.Warn; f("msg"). Значение nil означает, что
+// переменной присваивались разные или неизвестные функции.
+type funcValues map[*types.Var]*callTarget
+
 // extractMessageExpr достает аргумент сообщения и опирается на type info,
 // чтобы отличить реальные вызовы slog/zap от одноименных методов в другом коде.
-func extractMessageExpr(pass *analysis.Pass, call *ast.CallExpr) (ast.Expr, bool) {
-	fn, ok := calledFunction(pass, call)
+func extractMessageExpr(pass *analysis.Pass, cfg *settings, values funcValues, call *ast.CallExpr) (ast.Expr, bool) {
+	target, ok := calledFunction(pass, values, call)
 	if !ok {
 		return nil, false
 	}
 
-	pkg := fn.Pkg()
-	if pkg == nil {
+	msgIndex, ok := sinkMessageIndex(pass, cfg, target.fn)
+	if !ok || target.argOffset > len(call.Args) {
 		return nil, false
 	}
 
-	msgIndex, ok := messageArgIndex(pkg.Path(), fn.Name())
-	if !ok || msgIndex >= len(call.Args) {
+	if msgIndex == firstStringLiteralArg {
+		return firstStringLiteralExpr(pass, call.Args[target.argOffset:])
+	}
+
+	msgIndex += target.argOffset
+	if msgIndex >= len(call.Args) {
 		return nil, false
 	}
 
@@ -277,35 +1130,206 @@ func extractMessageExpr(pass *analysis.Pass, call *ast.CallExpr) (ast.Expr, bool
 	return expr, true
 }
 
-func calledFunction(pass *analysis.Pass, call *ast.CallExpr) (*types.Func, bool) {
-	switch fun := call.Fun.(type) {
+// sinkMessageIndex возвращает индекс аргумента сообщения для функции логирования.
+// Порядок источников: пользовательские extra-sinks, встроенные таблицы логгеров
+// и, наконец, факты об обертках, выведенные анализатором.
+func sinkMessageIndex(pass *analysis.Pass, cfg *settings, fn *types.Func) (int, bool) {
+	pkg := fn.Pkg()
+	if pkg == nil {
+		return 0, false
+	}
+
+	key := sinkKey{pkgPath: pkg.Path(), recv: receiverName(fn), name: fn.Name()}
+	if idx, ok := cfg.extraSinks[key]; ok {
+		return idx, true
+	}
+	if idx, ok := messageArgIndex(key.pkgPath, key.recv, key.name); ok {
+		return idx, true
+	}
+
+	var fact messageSinkFact
+	if pass.ImportObjectFact(fn.Origin(), &fact) {
+		return fact.ArgIndex, true
+	}
+
+	return 0, false
+}
+
+// firstStringLiteralExpr выбирает сообщение для Print/Println-подобных вызовов:
+// это первый строковый аргумент, в котором есть хотя бы один строковый литерал
+// или константа.
+func firstStringLiteralExpr(pass *analysis.Pass, args []ast.Expr) (ast.Expr, bool) {
+	for _, arg := range args {
+		if !isStringExpr(pass, arg) {
+			continue
+		}
+		if len(extractMessageFragments(pass.TypesInfo, arg)) == 0 {
+			continue
+		}
+		return arg, true
+	}
+
+	return nil, false
+}
+
+// calledFunction определяет, какую функцию вызывает call. Помимо прямых вызовов
+// поддерживаются method expression и вызовы через переменную с method value.
+func calledFunction(pass *analysis.Pass, values funcValues, call *ast.CallExpr) (callTarget, bool) {
+	if target, ok := resolveFuncExpr(pass, call.Fun); ok {
+		return target, true
+	}
+
+	ident, ok := stripParens(call.Fun).(*ast.Ident)
+	if !ok {
+		return callTarget{}, false
+	}
+
+	v, ok := pass.TypesInfo.Uses[ident].(*types.Var)
+	if !ok {
+		return callTarget{}, false
+	}
+
+	target := values[v]
+	if target == nil {
+		return callTarget{}, false
+	}
+
+	return *target, true
+}
+
+// resolveFuncExpr определяет функцию, на которую ссылается выражение:
+// имя функции, функцию пакета, method value или method expression.
+func resolveFuncExpr(pass *analysis.Pass, expr ast.Expr) (callTarget, bool) {
+	switch fun := stripParens(expr).(type) {
 	case *ast.SelectorExpr:
+		// Selections покрывает и конкретные методы, и методы интерфейсов
+		// (например, logrus.FieldLogger): в обоих случаях Obj() — *types.Func,
+		// объявленный в пакете логгера, даже если интерфейс встроен в пользовательский.
 		if sel := pass.TypesInfo.Selections[fun]; sel != nil {
-			if fn, ok := sel.Obj().(*types.Func); ok {
-				return fn, true
+			fn, ok := sel.Obj().(*types.Func)
+			if !ok {
+				return callTarget{}, false
 			}
-		}
-		if obj := pass.TypesInfo.Uses[fun.Sel]; obj != nil {
-			if fn, ok := obj.(*types.Func); ok {
-				return fn, true
+
+			target := callTarget{fn: fn}
+			if sel.Kind() == types.MethodExpr {
+				target.argOffset = 1
 			}
+			return target, true
+		}
+		if fn, ok := pass.TypesInfo.Uses[fun.Sel].(*types.Func); ok {
+			return callTarget{fn: fn}, true
 		}
 	case *ast.Ident:
-		if obj := pass.TypesInfo.Uses[fun]; obj != nil {
-			if fn, ok := obj.(*types.Func); ok {
-				return fn, true
-			}
+		if fn, ok := pass.TypesInfo.Uses[fun].(*types.Func); ok {
+			return callTarget{fn: fn}, true
 		}
 	}
 
-	return nil, false
+	return callTarget{}, false
+}
+
+// collectFuncValues находит переменные, в которые сохранены функции или методы:
+// f := logger.Warn, var g = (*slog.Logger).Info. Если переменной присваивается
+// что-то еще, мы не можем статически сказать, что вызывается, и забываем ее.
+func collectFuncValues(pass *analysis.Pass) funcValues {
+	values := make(funcValues)
+
+	record := func(lhs ast.Expr, rhs ast.Expr) {
+		ident, ok := stripParens(lhs).(*ast.Ident)
+		if !ok {
+			return
+		}
+
+		obj := pass.TypesInfo.Defs[ident]
+		if obj == nil {
+			obj = pass.TypesInfo.Uses[ident]
+		}
+		v, ok := obj.(*types.Var)
+		if !ok {
+			return
+		}
+		if _, ok := v.Type().Underlying().(*types.Signature); !ok {
+			return
+		}
+
+		target, ok := resolveFuncExpr(pass, rhs)
+		if prev, seen := values[v]; !ok || (seen && (prev == nil || *prev != target)) {
+			values[v] = nil
+			return
+		}
+		values[v] = &target
+	}
+
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			switch n := node.(type) {
+			case *ast.AssignStmt:
+				if len(n.Lhs) != len(n.Rhs) {
+					return true
+				}
+				for i := range n.Lhs {
+					record(n.Lhs[i], n.Rhs[i])
+				}
+			case *ast.ValueSpec:
+				if len(n.Names) != len(n.Values) {
+					return true
+				}
+				for i := range n.Names {
+					record(n.Names[i], n.Values[i])
+				}
+			}
+			return true
+		})
+	}
+
+	return values
+}
+
+func newLogrusMessageIndexes() map[string]int {
+	indexes := map[string]int{
+		"Log":   firstStringLiteralArg,
+		"Logln": firstStringLiteralArg,
+		"Logf":  1,
+	}
+	for _, level := range logrusLevels {
+		indexes[level] = firstStringLiteralArg
+		indexes[level+"ln"] = firstStringLiteralArg
+		indexes[level+"f"] = 0
+	}
+	return indexes
+}
+
+// receiverName возвращает имя именованного типа получателя метода
+// или пустую строку для обычных функций.
+func receiverName(fn *types.Func) string {
+	sig, ok := fn.Type().(*types.Signature)
+	if !ok || sig.Recv() == nil {
+		return ""
+	}
+
+	// Для методов интерфейса получателем является сам именованный интерфейс.
+	recv := sig.Recv().Type()
+	if ptr, ok := recv.(*types.Pointer); ok {
+		recv = ptr.Elem()
+	}
+	if named, ok := recv.(*types.Named); ok {
+		return named.Obj().Name()
+	}
+
+	return ""
 }
 
-func messageArgIndex(pkgPath, fnName string) (int, bool) {
+func messageArgIndex(pkgPath, recvName, fnName string) (int, bool) {
 	switch pkgPath {
 	case "log/slog":
 		idx, ok := slogMessageIndexes[fnName]
 		return idx, ok
+	case "log":
+		// Функции пакета log и методы *log.Logger называются одинаково,
+		// поэтому одна таблица покрывает оба случая, включая логгер из slog.NewLogLogger.
+		idx, ok := stdlogMessageIndexes[fnName]
+		return idx, ok
 	case "go.uber.org/zap":
 		if fnName == "Log" {
 			return 1, true
@@ -314,6 +1338,26 @@ func messageArgIndex(pkgPath, fnName string) (int, bool) {
 		if ok {
 			return 0, true
 		}
+	case "github.com/rs/zerolog":
+		// В zerolog сообщение передается в конце цепочки, поэтому важен
+		// тип получателя: Info() у Logger лишь создает *Event.
+		switch recvName {
+		case "Event":
+			idx, ok := zerologEventMessageIndexes[fnName]
+			return idx, ok
+		case "Logger":
+			idx, ok := zerologPrintMessageIndexes[fnName]
+			return idx, ok
+		}
+	case "github.com/sirupsen/logrus":
+		if _, ok := logrusReceivers[recvName]; !ok {
+			return 0, false
+		}
+		idx, ok := logrusMessageIndexes[fnName]
+		return idx, ok
+	case "github.com/rs/zerolog/log":
+		idx, ok := zerologPrintMessageIndexes[fnName]
+		return idx, ok
 	}
 
 	return 0, false
@@ -325,30 +1369,38 @@ func isStringExpr(pass *analysis.Pass, expr ast.Expr) bool {
 		return false
 	}
 
-	basic, ok := tv.Type.Underlying().(*types.Basic)
-	if !ok {
-		return false
-	}
-
-	return basic.Info()&types.IsString != 0
+	return isStringType(tv.Type)
 }
 
-func canRewriteMessageExpr(expr ast.Expr) bool {
-	lit, ok := stripParens(expr).(*ast.BasicLit)
-	return ok && lit.Kind == token.STRING
+// messageFragment — кусок сообщения, известный на этапе компиляции.
+type messageFragment struct {
+	text string
+	// node — литерал или ссылка на константу, из которой получен текст.
+	node ast.Expr
+	// constObj — константа, если текст получен из нее, иначе nil.
+	constObj *types.Const
+	// leading — фрагмент стоит в самом начале итогового сообщения.
+	leading bool
+	// trailing — фрагмент стоит в самом конце итогового сообщения
+	// (для шаблона Sprintf — если после него нет других частей).
+	trailing bool
 }
 
-// extractAllStringLiterals рекурсивно достает все строковые литералы из выражения.
-// Мы осознанно поддерживаем только два сценария:
+// extractMessageFragments рекурсивно достает из выражения все строковые куски,
+// значение которых известно на этапе компиляции. Поддерживаются:
 // 1) прямой литерал "message";
-// 2) конкатенация через +, где каждая сторона может быть либо литералом,
-// либо еще одной конкатенацией.
-// Любые другие узлы AST (вызовы функций, идентификаторы, форматирование) игнорируем.
-func extractAllStringLiterals(expr ast.Expr) []string {
-	literals := make([]string, 0, 1)
-
-	var walk func(ast.Expr)
-	walk = func(node ast.Expr) {
+// 2) именованная константа, в том числе из другого пакета (msgStart, pkg.Msg),
+// и любое другое константное выражение — его значение берем из type info;
+// 3) конкатенация через +, где каждая сторона может быть любым из вариантов выше;
+// 4) сборка сообщения через fmt.Sprintf/Sprint/Sprintln, strings.Join с литеральным
+// слайсом и errors.New(...).Error() — смотрим на литеральные аргументы этих вызовов.
+// Любые другие узлы AST (прочие вызовы, переменные) игнорируем.
+// Без type info (info == nil) распознаются только литералы и конкатенации.
+func extractMessageFragments(info *types.Info, expr ast.Expr) []messageFragment {
+	fragments := make([]messageFragment, 0, 1)
+
+	var walk func(ast.Expr, bool, bool)
+	walk = func(node ast.Expr, leading, trailing bool) {
 		if node == nil {
 			return
 		}
@@ -365,44 +1417,204 @@ func extractAllStringLiterals(expr ast.Expr) []string {
 				// Просто пропускаем узел и продолжаем обход.
 				return
 			}
-			literals = append(literals, text)
+			fragments = append(fragments, messageFragment{text: text, node: v, leading: leading, trailing: trailing})
+			return
 		case *ast.BinaryExpr:
-			if v.Op != token.ADD {
+			if v.Op == token.ADD {
+				walk(v.X, leading, false)
+				walk(v.Y, false, trailing)
+				return
+			}
+		}
+
+		if info == nil {
+			return
+		}
+
+		tv, ok := info.Types[node]
+		if ok && tv.Value != nil && tv.Value.Kind() == constant.String {
+			fragment := messageFragment{text: constant.StringVal(tv.Value), node: node, leading: leading, trailing: trailing}
+			if obj, ok := info.Uses[identOf(node)].(*types.Const); ok {
+				fragment.constObj = obj
+			}
+			fragments = append(fragments, fragment)
+			return
+		}
+
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return
+		}
+
+		// Внутри форматирующих вызовов проверяем литеральные куски. Начальным
+		// считается только первый аргумент: шаблон Sprintf, первый операнд
+		// Sprint или первый элемент strings.Join. Конечным — шаблон Sprintf
+		// (аргументы подставляются внутрь него) и последний операнд остальных.
+		switch pkgPath, name := packageFuncName(info, call); {
+		case pkgPath == "fmt" && name == "Sprintf":
+			for i, arg := range call.Args {
+				walk(arg, leading && i == 0, trailing && i == 0)
+			}
+		case pkgPath == "fmt" && (name == "Sprint" || name == "Sprintln"):
+			for i, arg := range call.Args {
+				walk(arg, leading && i == 0, trailing && i == len(call.Args)-1)
+			}
+		case pkgPath == "strings" && name == "Join" && len(call.Args) == 2:
+			slice, ok := stripParens(call.Args[0]).(*ast.CompositeLit)
+			if !ok {
 				return
 			}
-			walk(v.X)
-			walk(v.Y)
+			for i, elt := range slice.Elts {
+				if _, keyed := elt.(*ast.KeyValueExpr); keyed {
+					return
+				}
+				if i > 0 {
+					walk(call.Args[1], false, false)
+				}
+				walk(elt, leading && i == 0, trailing && i == len(slice.Elts)-1)
+			}
+		default:
+			if text, ok := errorsNewText(info, call); ok {
+				walk(text, leading, trailing)
+			}
 		}
 	}
 
-	walk(expr)
-	return literals
+	walk(expr, true, true)
+	return fragments
+}
+
+// packageFuncName возвращает путь пакета и имя функции уровня пакета,
+// которую вызывает call, например ("fmt", "Sprintf").
+func packageFuncName(info *types.Info, call *ast.CallExpr) (string, string) {
+	fn, ok := info.Uses[identOf(stripParens(call.Fun))].(*types.Func)
+	if !ok || fn.Pkg() == nil || fn.Type().(*types.Signature).Recv() != nil {
+		return "", ""
+	}
+	return fn.Pkg().Path(), fn.Name()
+}
+
+// errorsNewText распознает вызов errors.New(text).Error() и возвращает text.
+func errorsNewText(info *types.Info, call *ast.CallExpr) (ast.Expr, bool) {
+	sel, ok := stripParens(call.Fun).(*ast.SelectorExpr)
+	if !ok || sel.Sel.Name != "Error" || len(call.Args) != 0 {
+		return nil, false
+	}
+
+	inner, ok := stripParens(sel.X).(*ast.CallExpr)
+	if !ok || len(inner.Args) != 1 {
+		return nil, false
+	}
+
+	if pkgPath, name := packageFuncName(info, inner); pkgPath != "errors" || name != "New" {
+		return nil, false
+	}
+
+	return inner.Args[0], true
+}
+
+// identOf возвращает идентификатор, на который ссылается выражение:
+// сам идентификатор или имя после точки в pkg.Name.
+func identOf(expr ast.Expr) *ast.Ident {
+	switch v := expr.(type) {
+	case *ast.Ident:
+		return v
+	case *ast.SelectorExpr:
+		return v.Sel
+	default:
+		return nil
+	}
+}
+
+// collectConstLiterals сопоставляет строковые константы пакета с литералами
+// в их объявлениях, чтобы автофикс мог переписать само объявление.
+func collectConstLiterals(pass *analysis.Pass) map[*types.Const]*ast.BasicLit {
+	lits := make(map[*types.Const]*ast.BasicLit)
+	for _, file := range pass.Files {
+		ast.Inspect(file, func(node ast.Node) bool {
+			decl, ok := node.(*ast.GenDecl)
+			if !ok {
+				return true
+			}
+			if decl.Tok != token.CONST {
+				return false
+			}
+
+			for _, spec := range decl.Specs {
+				vs := spec.(*ast.ValueSpec)
+				for i, name := range vs.Names {
+					if i >= len(vs.Values) {
+						break
+					}
+					lit, ok := stripParens(vs.Values[i]).(*ast.BasicLit)
+					if !ok || lit.Kind != token.STRING {
+						continue
+					}
+					if obj, ok := pass.TypesInfo.Defs[name].(*types.Const); ok {
+						lits[obj] = lit
+					}
+				}
+			}
+			return false
+		})
+	}
+	return lits
+}
+
+// rewritableLiteral возвращает литерал, который можно переписать автофиксом:
+// сам литерал сообщения или литерал в объявлении константы текущего пакета.
+// Константы других пакетов не трогаем: их исходники не принадлежат этому пакету.
+func rewritableLiteral(pass *analysis.Pass, constLits map[*types.Const]*ast.BasicLit, fragment messageFragment) *ast.BasicLit {
+	if lit, ok := fragment.node.(*ast.BasicLit); ok {
+		return lit
+	}
+	if fragment.constObj == nil || fragment.constObj.Pkg() != pass.Pkg {
+		return nil
+	}
+	return constLits[fragment.constObj]
+}
+
+// constFix — правка объявления константы по одному правилу.
+type constFix struct {
+	lit  *ast.BasicLit
+	rule string
 }
 
 // buildDiagnostic собирает диагностику и, при необходимости, SuggestedFix.
-// Важно, что SuggestedFix предлагается только для безопасного сценария, когда
-// можно заменить весь исходный аргумент целиком на новый строковый литерал.
-func buildDiagnostic(expr ast.Expr, message, currentText, fixedText string, allowFix bool) analysis.Diagnostic {
+// Диагностика всегда указывает на аргумент сообщения в месте вызова, а для
+// сообщения из константы дополнительно ссылается на ее объявление.
+// SuggestedFix предлагается только для безопасного сценария, когда можно
+// заменить литерал fixLit целиком на новый строковый литерал.
+func buildDiagnostic(msgs catalog, expr ast.Expr, fragment messageFragment, message, fixedText string, fixLit *ast.BasicLit) analysis.Diagnostic {
 	diagnostic := analysis.Diagnostic{
 		Pos:     expr.Pos(),
 		End:     expr.End(),
 		Message: message,
 	}
 
+	if fragment.constObj != nil && fragment.constObj.Pos().IsValid() {
+		diagnostic.Related = []analysis.RelatedInformation{
+			{
+				Pos:     fragment.constObj.Pos(),
+				Message: msgs.sprintf(msgRelatedConstant, fragment.constObj.Name()),
+			},
+		}
+	}
+
 	// Если правка не разрешена или нечего менять, возвращаем только предупреждение.
-	if !allowFix || fixedText == "" || fixedText == currentText {
+	if fixLit == nil || fixedText == "" || fixedText == fragment.text {
 		return diagnostic
 	}
 
-	// Формируем правку как замену всего expression-узла на quoted-строку.
-	// Такой edit корректен только для *ast.BasicLit, что гарантируется вызывающей стороной.
+	// Формируем правку как замену литерала на quoted-строку: это либо сам
+	// аргумент вызова, либо значение в объявлении константы.
 	diagnostic.SuggestedFixes = []analysis.SuggestedFix{
 		{
-			Message: "исправить сообщение логирования",
+			Message: msgs.text(msgFixTitle),
 			TextEdits: []analysis.TextEdit{
 				{
-					Pos:     expr.Pos(),
-					End:     expr.End(),
+					Pos:     fixLit.Pos(),
+					End:     fixLit.End(),
 					NewText: []byte(strconv.Quote(fixedText)),
 				},
 			},
@@ -435,81 +1647,60 @@ func firstVisibleRune(text string) (int, rune, int, bool) {
 	return 0, 0, 0, false
 }
 
-func containsNonEnglishLetters(text string) bool {
+func containsNonEnglishLetters(text string, policy letterPolicy) bool {
 	for _, r := range text {
-		if unicode.IsLetter(r) && !unicode.In(r, unicode.Latin) {
+		if !policy.allows(r) {
 			return true
 		}
 	}
 	return false
 }
 
-func containsSpecialSymbolsOrEmoji(text string) bool {
-	if strings.Contains(text, "...") {
-		return true
-	}
-
-	for _, r := range text {
-		if isForbiddenPunctuation(r) || isEmojiRune(r) {
+func containsSensitiveData(text string, patterns []sensitivePattern) bool {
+	for _, pattern := range patterns {
+		if len(pattern.find(text)) > 0 {
 			return true
 		}
 	}
 	return false
 }
 
-func stripSpecialSymbolsAndEmoji(text string) string {
-	text = strings.ReplaceAll(text, "...", "")
-
-	var b strings.Builder
-	for _, r := range text {
-		if isForbiddenPunctuation(r) || isEmojiRune(r) {
-			continue
+// redactSensitiveData маскирует те же совпадения, что находит containsSensitiveData:
+// фразы из исключений остаются в автофиксе как есть.
+func redactSensitiveData(text string, patterns []sensitivePattern) string {
+	redacted := text
+	for _, pattern := range patterns {
+		if spans := pattern.find(redacted); len(spans) > 0 {
+			redacted = redactSpans(redacted, spans)
 		}
-		b.WriteRune(r)
-	}
-
-	return strings.Join(strings.Fields(strings.TrimSpace(b.String())), " ")
-}
-
-func isForbiddenPunctuation(r rune) bool {
-	switch r {
-	case '!', '?', '…':
-		return true
-	default:
-		return false
 	}
+	return redacted
 }
 
-func isEmojiRune(r rune) bool {
-	switch {
-	case r >= 0x1F300 && r <= 0x1FAFF:
-		return true
-	case r >= 0x2600 && r <= 0x27BF:
-		return true
-	case r == 0xFE0F:
-		return true
-	default:
-		return false
+// find возвращает совпадения паттерна, которые не покрыты целиком ни одним
+// совпадением исключений: "token" в "token bucket refilled" при исключении
+// "token bucket" нарушением не считается.
+func (p sensitivePattern) find(text string) [][2]int {
+	var spans [][2]int
+	for _, match := range p.re.FindAllStringIndex(text, -1) {
+		if !p.allowed(text, match[0], match[1]) {
+			spans = append(spans, [2]int{match[0], match[1]})
+		}
 	}
+	return spans
 }
 
-func containsSensitiveData(text string, patterns []sensitivePattern) bool {
-	for _, pattern := range patterns {
-		if pattern.re.MatchString(text) {
-			return true
+func (p sensitivePattern) allowed(text string, start, end int) bool {
+	for _, re := range p.allow {
+		for _, match := range re.FindAllStringIndex(text, -1) {
+			if match[0] <= start && end <= match[1] {
+				return true
+			}
 		}
 	}
 	return false
 }
 
-func redactSensitiveData(text string, patterns []sensitivePattern) string {
-	redacted := text
-	for _, pattern := range patterns {
-		redacted = pattern.re.ReplaceAllString(redacted, sensitiveReplacement)
-	}
-	return redacted
-}
-
 func stripParens(expr ast.Expr) ast.Expr {
 	for {
 		paren, ok := expr.(*ast.ParenExpr)
@@ -539,6 +1730,64 @@ func normalizeMap(raw any) (map[string]any, bool) {
 	}
 }
 
+func toSinkMap(raw any) (map[string]int, error) {
+	if typed, ok := raw.(map[string]int); ok {
+		return typed, nil
+	}
+
+	m, ok := normalizeMap(raw)
+	if !ok {
+		return nil, newError(errfGotType, ErrExpectedSinkMap, raw)
+	}
+
+	result := make(map[string]int, len(m))
+	for name, value := range m {
+		idx, ok := toInt(value)
+		if !ok {
+			return nil, newError(errfQuotedValue, ErrInvalidSinkIndex, name, value)
+		}
+		result[name] = idx
+	}
+	return result, nil
+}
+
+// toInt принимает числа в том виде, в каком их отдают YAML (int) и JSON (float64) декодеры.
+func toInt(raw any) (int, bool) {
+	switch value := raw.(type) {
+	case int:
+		return value, true
+	case int64:
+		return int(value), true
+	case uint64:
+		return int(value), true
+	case float64:
+		if value != float64(int(value)) {
+			return 0, false
+		}
+		return int(value), true
+	default:
+		return 0, false
+	}
+}
+
+// toFloat принимает как дробные, так и целые числа: "threshold: 4" в YAML — это int.
+func toFloat(raw any) (float64, bool) {
+	switch value := raw.(type) {
+	case float64:
+		return value, true
+	case float32:
+		return float64(value), true
+	case int:
+		return float64(value), true
+	case int64:
+		return float64(value), true
+	case uint64:
+		return float64(value), true
+	default:
+		return 0, false
+	}
+}
+
 func toStringSlice(raw any) ([]string, error) {
 	switch value := raw.(type) {
 	case string:
@@ -562,7 +1811,7 @@ func toStringSlice(raw any) ([]string, error) {
 		for _, item := range value {
 			str, ok := item.(string)
 			if !ok {
-				return nil, fmt.Errorf("%w: %T", ErrExpectedStringListItem, item)
+				return nil, newError(errfType, ErrExpectedStringListItem, item)
 			}
 			trimmed := strings.TrimSpace(str)
 			if trimmed == "" {
@@ -572,6 +1821,6 @@ func toStringSlice(raw any) ([]string, error) {
 		}
 		return result, nil
 	default:
-		return nil, fmt.Errorf("%w: получено %T", ErrExpectedStringSlice, raw)
+		return nil, newError(errfGotType, ErrExpectedStringSlice, raw)
 	}
 }
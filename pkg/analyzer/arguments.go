@@ -0,0 +1,133 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/types"
+	"strings"
+	"unicode"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// checkSensitiveArgs ищет среди аргументов вызова логгера (кроме самого
+// сообщения) переменные и поля, имена которых похожи на секреты:
+// password, cfg.APIKey, req.Token. Значения внутри конструкторов slog.Attr
+// и zap.Field тоже проверяются: slog.String("p", password).
+func checkSensitiveArgs(pass *analysis.Pass, cfg *settings, values funcValues, call *ast.CallExpr) {
+	target, ok := calledFunction(pass, values, call)
+	if !ok || target.argOffset > len(call.Args) {
+		return
+	}
+
+	msgIndex, ok := sinkMessageIndex(pass, cfg, target.fn)
+	if !ok {
+		return
+	}
+
+	args := call.Args[target.argOffset:]
+	for i, arg := range args {
+		if i == msgIndex {
+			continue
+		}
+		// В Print-подобных вызовах сообщением служит аргумент с литералом,
+		// остальные аргументы проверяем как обычные.
+		if msgIndex == firstStringLiteralArg && len(extractMessageFragments(pass.TypesInfo, arg)) > 0 {
+			continue
+		}
+
+		for _, expr := range sensitiveArgCandidates(pass, values, arg) {
+			if isSensitiveIdentifier(pass, expr, cfg.patterns) {
+				cfg.report(pass, ruleSensitiveArg, analysis.Diagnostic{
+					Pos:     expr.Pos(),
+					End:     expr.End(),
+					Message: cfg.msgs.text(diagSensitiveArg),
+				})
+			}
+		}
+	}
+}
+
+// sensitiveArgCandidates возвращает выражения, имена которых стоит проверить:
+// сам аргумент или значения внутри конструктора атрибута.
+func sensitiveArgCandidates(pass *analysis.Pass, values funcValues, arg ast.Expr) []ast.Expr {
+	call, ok := stripParens(arg).(*ast.CallExpr)
+	if !ok {
+		return []ast.Expr{arg}
+	}
+
+	if len(attributeKeys(pass, values, call)) == 0 {
+		return nil
+	}
+
+	// Первый аргумент конструктора — ключ, его проверяет отдельное правило.
+	return call.Args[1:]
+}
+
+// isSensitiveIdentifier проверяет имя переменной или поля по паттернам
+// чувствительных данных. Константы пропускаем: это ключи и тексты, а не
+// значения, пришедшие в рантайме. Числа и булевы значения секретами не считаем.
+func isSensitiveIdentifier(pass *analysis.Pass, expr ast.Expr, patterns []sensitivePattern) bool {
+	ident := identOf(stripParens(expr))
+	if ident == nil {
+		return false
+	}
+
+	tv, ok := pass.TypesInfo.Types[stripParens(expr)]
+	if !ok || tv.Value != nil || !tv.IsValue() {
+		return false
+	}
+	if basic, ok := tv.Type.Underlying().(*types.Basic); ok && basic.Info()&types.IsString == 0 {
+		return false
+	}
+
+	for _, phrase := range identifierPhrases(ident.Name) {
+		if containsSensitiveData(phrase, patterns) {
+			return true
+		}
+	}
+	return false
+}
+
+// identifierPhrases превращает имя идентификатора в фразы, к которым применимы
+// паттерны с границами слов: "cfgAPIKey" -> "cfg api key", а также пары
+// соседних слов через подчеркивание ("cfg_api", "api_key"), чтобы сработали
+// паттерны вида api[_-]?key.
+func identifierPhrases(name string) []string {
+	words := splitIdentifier(name)
+	if len(words) == 0 {
+		return nil
+	}
+
+	phrases := []string{strings.Join(words, " ")}
+	for i := 0; i+1 < len(words); i++ {
+		phrases = append(phrases, words[i]+"_"+words[i+1])
+	}
+	return phrases
+}
+
+// splitIdentifier разбивает camelCase, PascalCase и snake_case на слова
+// в нижнем регистре. Аббревиатуры сохраняются целиком: "HTTPToken" -> http, token.
+func splitIdentifier(name string) []string {
+	var words []string
+	for _, part := range strings.FieldsFunc(name, func(r rune) bool { return r == '_' || r == '-' }) {
+		runes := []rune(part)
+		start := 0
+		for i := 1; i < len(runes); i++ {
+			prev, cur := runes[i-1], runes[i]
+			var next rune
+			if i+1 < len(runes) {
+				next = runes[i+1]
+			}
+
+			lowerToUpper := unicode.IsLower(prev) && unicode.IsUpper(cur)
+			acronymEnd := unicode.IsUpper(prev) && unicode.IsUpper(cur) && unicode.IsLower(next)
+			digitEdge := unicode.IsDigit(prev) != unicode.IsDigit(cur)
+			if lowerToUpper || acronymEnd || digitEdge {
+				words = append(words, strings.ToLower(string(runes[start:i])))
+				start = i
+			}
+		}
+		words = append(words, strings.ToLower(string(runes[start:])))
+	}
+	return words
+}
@@ -0,0 +1,147 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// slogAttrConstructors перечисляет конструкторы slog.Attr: ключ у всех первым аргументом.
+var slogAttrConstructors = map[string]struct{}{
+	"String":   {},
+	"Int":      {},
+	"Int64":    {},
+	"Uint64":   {},
+	"Float64":  {},
+	"Bool":     {},
+	"Time":     {},
+	"Duration": {},
+	"Any":      {},
+	"Group":    {},
+}
+
+// checkAttributeKeys проверяет ключи структурированных атрибутов в вызове:
+// конструкторы slog.Attr и zap.Field, а также пары ключ/значение в slog
+// и в ...w-методах zap.SugaredLogger.
+func checkAttributeKeys(pass *analysis.Pass, cfg *settings, values funcValues, call *ast.CallExpr) {
+	for _, key := range attributeKeys(pass, values, call) {
+		tv, ok := pass.TypesInfo.Types[stripParens(key)]
+		if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+			continue
+		}
+
+		if containsSensitiveData(constant.StringVal(tv.Value), cfg.patterns) {
+			cfg.report(pass, ruleSensitiveKey, analysis.Diagnostic{
+				Pos:     key.Pos(),
+				End:     key.End(),
+				Message: cfg.msgs.text(diagSensitiveKey),
+			})
+		}
+	}
+}
+
+// attributeKeys возвращает выражения ключей атрибутов, которые задает вызов.
+func attributeKeys(pass *analysis.Pass, values funcValues, call *ast.CallExpr) []ast.Expr {
+	target, ok := calledFunction(pass, values, call)
+	if !ok || target.fn.Pkg() == nil || target.argOffset > len(call.Args) {
+		return nil
+	}
+
+	fn := target.fn
+	args := call.Args[target.argOffset:]
+	recv := receiverName(fn)
+
+	switch fn.Pkg().Path() {
+	case "log/slog":
+		if _, ok := slogAttrConstructors[fn.Name()]; ok && recv == "" && len(args) > 0 {
+			return args[:1]
+		}
+		if recv != "Logger" && recv != "" {
+			return nil
+		}
+		if fn.Name() == "With" {
+			return alternatingKeys(pass, args)
+		}
+		if idx, ok := slogMessageIndexes[fn.Name()]; ok && idx < len(args) {
+			return alternatingKeys(pass, args[idx+1:])
+		}
+	case "go.uber.org/zap":
+		if recv == "" && isZapFieldConstructor(fn) && len(args) > 0 {
+			return args[:1]
+		}
+		if recv != "SugaredLogger" {
+			return nil
+		}
+		if fn.Name() == "With" {
+			return alternatingKeys(pass, args)
+		}
+		if _, ok := zapMessageFirstMethods[fn.Name()]; ok && strings.HasSuffix(fn.Name(), "w") && len(args) > 0 {
+			return alternatingKeys(pass, args[1:])
+		}
+	}
+
+	return nil
+}
+
+// alternatingKeys разбирает вариадические аргументы вида "k1", v1, "k2", v2
+// так же, как это делают slog и zap: готовые атрибуты (slog.Attr, zap.Field)
+// занимают одну позицию, строковый аргумент считается ключом и забирает
+// следующий аргумент как значение.
+func alternatingKeys(pass *analysis.Pass, args []ast.Expr) []ast.Expr {
+	var keys []ast.Expr
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if isAttributeValue(pass, arg) {
+			continue
+		}
+		if !isStringExpr(pass, arg) {
+			continue
+		}
+		keys = append(keys, arg)
+		i++
+	}
+	return keys
+}
+
+// isAttributeValue сообщает, является ли выражение готовым атрибутом slog.Attr или zap.Field.
+func isAttributeValue(pass *analysis.Pass, expr ast.Expr) bool {
+	tv, ok := pass.TypesInfo.Types[stripParens(expr)]
+	if !ok || tv.Type == nil {
+		return false
+	}
+	return isNamedType(tv.Type, "log/slog", "Attr") || isZapField(tv.Type)
+}
+
+// isZapFieldConstructor распознает функции zap вида func(key string, ...) zap.Field.
+func isZapFieldConstructor(fn *types.Func) bool {
+	sig := fn.Type().(*types.Signature)
+	if sig.Results().Len() != 1 || sig.Params().Len() == 0 {
+		return false
+	}
+	if !isStringType(sig.Params().At(0).Type()) {
+		return false
+	}
+	return isZapField(sig.Results().At(0).Type())
+}
+
+// isZapField учитывает, что в zap Field — алиас для zapcore.Field.
+func isZapField(t types.Type) bool {
+	return isNamedType(t, "go.uber.org/zap", "Field") || isNamedType(t, "go.uber.org/zap/zapcore", "Field")
+}
+
+func isNamedType(t types.Type, pkgPath, name string) bool {
+	named, ok := types.Unalias(t).(*types.Named)
+	if !ok {
+		return false
+	}
+	obj := named.Obj()
+	return obj.Pkg() != nil && obj.Pkg().Path() == pkgPath && obj.Name() == name
+}
+
+func isStringType(t types.Type) bool {
+	basic, ok := t.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsString != 0
+}
@@ -0,0 +1,274 @@
+package analyzer
+
+import (
+	"bytes"
+	"cmp"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// baselineVersion — версия формата файла baseline.
+const baselineVersion = 1
+
+// Baseline — содержимое файла baseline: находки, которые уже были в коде
+// на момент включения линтера и не должны попадать в отчет.
+type Baseline struct {
+	Version  int             `json:"version"`
+	Findings []BaselineEntry `json:"findings"`
+}
+
+// BaselineEntry — одна находка. Ключ не содержит номера строки: File — путь
+// относительно каталога файла baseline, Hash — хеш нормализованного кода,
+// на который указывает диагностика. Count — число одинаковых находок в файле.
+type BaselineEntry struct {
+	Package string `json:"package"`
+	File    string `json:"file"`
+	Rule    string `json:"rule"`
+	Hash    string `json:"hash"`
+	Count   int    `json:"count"`
+}
+
+// NewBaselineEntry строит запись для диагностики анализатора. root — каталог
+// файла baseline, src — содержимое файла, в котором находится диагностика.
+// Диагностики без кода правила в Category (не от logmsglint) пропускаются.
+func NewBaselineEntry(fset *token.FileSet, pkgPath, root string, diagnostic analysis.Diagnostic, src []byte) (BaselineEntry, bool) {
+	code, _, ok := ParseCategory(diagnostic.Category)
+	if !ok {
+		return BaselineEntry{}, false
+	}
+	rule, _ := resolveRule(code)
+
+	file := fset.File(diagnostic.Pos)
+	if file == nil {
+		return BaselineEntry{}, false
+	}
+
+	return BaselineEntry{
+		Package: pkgPath,
+		File:    baselinePath(root, file.Name()),
+		Rule:    rule,
+		Hash:    baselineHash(diagnostic, file, src),
+		Count:   1,
+	}, true
+}
+
+// WriteBaseline склеивает одинаковые записи, сортирует их и пишет файл.
+func WriteBaseline(path string, entries []BaselineEntry) error {
+	merged := make(map[baselineKey]*BaselineEntry, len(entries))
+	for _, entry := range entries {
+		key := baselineKey{pkgPath: entry.Package, file: entry.File, rule: entry.Rule, hash: entry.Hash}
+		if existing, ok := merged[key]; ok {
+			existing.Count += entry.Count
+			continue
+		}
+		entry := entry
+		merged[key] = &entry
+	}
+
+	baseline := Baseline{Version: baselineVersion, Findings: make([]BaselineEntry, 0, len(merged))}
+	for _, entry := range merged {
+		baseline.Findings = append(baseline.Findings, *entry)
+	}
+	slices.SortFunc(baseline.Findings, func(a, b BaselineEntry) int {
+		return cmp.Or(
+			cmp.Compare(a.Package, b.Package),
+			cmp.Compare(a.File, b.File),
+			cmp.Compare(a.Rule, b.Rule),
+			cmp.Compare(a.Hash, b.Hash),
+		)
+	})
+
+	data, err := json.MarshalIndent(baseline, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+// baselineKey — ключ находки внутри пакета.
+type baselineKey struct {
+	pkgPath string
+	file    string
+	rule    string
+	hash    string
+}
+
+// baselineSettings — загруженный baseline: каталог для относительных путей
+// и число известных находок по ключу.
+type baselineSettings struct {
+	root     string
+	findings map[baselineKey]int
+}
+
+// loadBaseline читает файл baseline. Пустой путь означает, что baseline не используется.
+func loadBaseline(path string) (*baselineSettings, error) {
+	if path == "" {
+		return nil, nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, newError(errfQuotedValue, ErrInvalidBaseline, path, err)
+	}
+
+	var baseline Baseline
+	if err := json.Unmarshal(data, &baseline); err != nil {
+		return nil, newError(errfQuotedValue, ErrInvalidBaseline, path, err)
+	}
+	if baseline.Version != baselineVersion {
+		return nil, newError(errfQuotedValue, ErrInvalidBaseline, path, baseline.Version)
+	}
+
+	root, err := filepath.Abs(filepath.Dir(path))
+	if err != nil {
+		return nil, newError(errfQuotedValue, ErrInvalidBaseline, path, err)
+	}
+
+	settings := &baselineSettings{root: root, findings: make(map[baselineKey]int, len(baseline.Findings))}
+	for _, entry := range baseline.Findings {
+		key := baselineKey{pkgPath: entry.Package, file: entry.File, rule: entry.Rule, hash: entry.Hash}
+		settings.findings[key] += max(entry.Count, 1)
+	}
+	return settings, nil
+}
+
+// baselinePass — состояние baseline на время одного прохода: сколько находок
+// по каждому ключу еще можно пропустить.
+type baselinePass struct {
+	root      string
+	remaining map[baselineKey]int
+	sources   map[string][]byte
+	readFile  func(string) ([]byte, error)
+}
+
+// forPass выбирает записи пакета. Записи файлов, которых нет в проходе,
+// не учитываются: тестовый и обычный варианты пакета анализируются отдельно.
+func (b *baselineSettings) forPass(pass *analysis.Pass) *baselinePass {
+	if b == nil {
+		return nil
+	}
+
+	files := make(map[string]struct{}, len(pass.Files))
+	for _, file := range pass.Files {
+		files[baselinePath(b.root, pass.Fset.File(file.Pos()).Name())] = struct{}{}
+	}
+
+	bp := &baselinePass{root: b.root, remaining: make(map[baselineKey]int), sources: make(map[string][]byte), readFile: pass.ReadFile}
+	if bp.readFile == nil {
+		bp.readFile = os.ReadFile
+	}
+	for key, count := range b.findings {
+		if _, ok := files[key.file]; ok && key.pkgPath == pass.Pkg.Path() {
+			bp.remaining[key] = count
+		}
+	}
+	return bp
+}
+
+// match сообщает, есть ли диагностика в baseline, и уменьшает счетчик записи.
+func (bp *baselinePass) match(pass *analysis.Pass, rule string, diagnostic analysis.Diagnostic) bool {
+	if bp == nil || len(bp.remaining) == 0 {
+		return false
+	}
+
+	file := pass.Fset.File(diagnostic.Pos)
+	if file == nil {
+		return false
+	}
+	src, ok := bp.sources[file.Name()]
+	if !ok {
+		src, _ = bp.readFile(file.Name())
+		bp.sources[file.Name()] = src
+	}
+
+	key := baselineKey{
+		pkgPath: pass.Pkg.Path(),
+		file:    baselinePath(bp.root, file.Name()),
+		rule:    rule,
+		hash:    baselineHash(diagnostic, file, src),
+	}
+	if bp.remaining[key] == 0 {
+		return false
+	}
+	bp.remaining[key]--
+	return true
+}
+
+// reportFixed сообщает о записях baseline, находок по которым больше нет:
+// их нужно убрать из файла, иначе они скроют новые находки с тем же ключом.
+func (s *settings) reportFixed(pass *analysis.Pass) {
+	if s.baselinePass == nil {
+		return
+	}
+
+	positions := make(map[string]token.Pos, len(pass.Files))
+	for _, file := range pass.Files {
+		positions[baselinePath(s.baselinePass.root, pass.Fset.File(file.Pos()).Name())] = file.Package
+	}
+
+	keys := make([]baselineKey, 0, len(s.baselinePass.remaining))
+	for key, count := range s.baselinePass.remaining {
+		if count > 0 {
+			keys = append(keys, key)
+		}
+	}
+	slices.SortFunc(keys, func(a, b baselineKey) int {
+		return cmp.Or(cmp.Compare(a.file, b.file), cmp.Compare(a.rule, b.rule), cmp.Compare(a.hash, b.hash))
+	})
+
+	for _, key := range keys {
+		s.report(pass, ruleBaseline, analysis.Diagnostic{
+			Pos:     positions[key.file],
+			Message: s.msgs.sprintf(diagBaselineFixed, s.baselinePass.remaining[key], key.rule, shortHash(key.hash)),
+		})
+	}
+}
+
+// shortHash сокращает хеш в сообщениях, как git сокращает идентификаторы коммитов.
+func shortHash(hash string) string {
+	if len(hash) > 12 {
+		return hash[:12]
+	}
+	return hash
+}
+
+// baselineHash хеширует код под диагностикой со схлопнутыми пробелами: ключ
+// не зависит от номера строки и отступов. Сообщение в хеш не входит, потому что
+// его текст зависит от language, а правило и так входит в ключ записи.
+func baselineHash(diagnostic analysis.Diagnostic, file *token.File, src []byte) string {
+	sum := sha256.Sum256([]byte(strings.Join(strings.Fields(diagnosticSnippet(diagnostic, file, src)), " ")))
+	return hex.EncodeToString(sum[:])
+}
+
+// diagnosticSnippet возвращает код от Pos до End, а без End — до конца строки.
+func diagnosticSnippet(diagnostic analysis.Diagnostic, file *token.File, src []byte) string {
+	start := file.Offset(diagnostic.Pos)
+	if start > len(src) {
+		return ""
+	}
+
+	end := len(src)
+	if diagnostic.End.IsValid() && file.Offset(diagnostic.End) <= len(src) {
+		end = file.Offset(diagnostic.End)
+	} else if i := bytes.IndexByte(src[start:], '\n'); i >= 0 {
+		end = start + i
+	}
+	return string(src[start:end])
+}
+
+// baselinePath приводит путь файла к виду относительно каталога baseline
+// с прямыми слешами, чтобы файл одинаково работал на Linux, macOS и Windows.
+func baselinePath(root, name string) string {
+	if rel, err := filepath.Rel(root, name); err == nil {
+		name = rel
+	}
+	return filepath.ToSlash(name)
+}
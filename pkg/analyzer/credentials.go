@@ -0,0 +1,186 @@
+package analyzer
+
+import (
+	"math"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode"
+)
+
+const (
+	defaultEntropyThreshold = 4.0
+	defaultEntropyMinLength = 20
+)
+
+// credentialFormat — известный формат ключа или токена, который узнается
+// по префиксу и структуре без ключевых слов вокруг.
+type credentialFormat struct {
+	name string
+	re   *regexp.Regexp
+}
+
+var credentialFormats = []credentialFormat{
+	{name: "AWS access key", re: regexp.MustCompile(`\b(?:AKIA|ASIA)[0-9A-Z]{16}\b`)},
+	{name: "JWT", re: regexp.MustCompile(`\beyJ[A-Za-z0-9_-]{8,}\.eyJ[A-Za-z0-9_-]{8,}\.[A-Za-z0-9_-]{8,}`)},
+	{name: "GitHub token", re: regexp.MustCompile(`\b(?:gh[pousr]_[A-Za-z0-9]{36,}|github_pat_[A-Za-z0-9_]{22,})\b`)},
+	{name: "Slack token", re: regexp.MustCompile(`\bxox[abpors]-[A-Za-z0-9-]{10,}`)},
+	{name: "Google API key", re: regexp.MustCompile(`\bAIza[0-9A-Za-z_-]{35}\b`)},
+	{name: "Stripe key", re: regexp.MustCompile(`\b[sr]k_live_[0-9A-Za-z]{16,}\b`)},
+	{name: "PEM private key", re: regexp.MustCompile(`-----BEGIN (?:[A-Z]+ )*PRIVATE KEY-----`)},
+}
+
+// entropyCandidate выделяет из текста токены, похожие на base64/hex/url-safe строки.
+var entropyCandidate = regexp.MustCompile(`[A-Za-z0-9+/_=-]+`)
+
+// urlWithScheme выделяет URL: сегменты их пути оцениваются по отдельности.
+var urlWithScheme = regexp.MustCompile(`[A-Za-z][A-Za-z0-9+.-]*://[^\s"'<>]+`)
+
+// pathSegment — сегмент обычного пути: слово в нижнем регистре или с заглавной
+// буквы, цифры, "_" и "-". Сегменты base64 вроде K7MDENG сюда не подходят.
+var pathSegment = regexp.MustCompile(`^(?:[a-z0-9_-]*|[A-Z][a-z0-9_-]*)$`)
+
+// entropySettings — пороги эвристики энтропии.
+type entropySettings struct {
+	threshold float64
+	minLength int
+}
+
+// findCredential возвращает название первого найденного в тексте секрета:
+// формата из каталога или длинного токена с высокой энтропией.
+func findCredential(text string, entropy entropySettings, msgs catalog) (string, bool) {
+	for _, format := range credentialFormats {
+		if format.re.MatchString(text) {
+			return format.name, true
+		}
+	}
+
+	for _, token := range entropyTokens(text) {
+		for _, part := range token.parts {
+			if isHighEntropyToken(part, entropy) {
+				return msgs.text(msgHighEntropy), true
+			}
+		}
+		if token.whole && isHighEntropyToken(text[token.start:token.end], entropy) {
+			return msgs.text(msgHighEntropy), true
+		}
+	}
+
+	return "", false
+}
+
+// redactCredentials заменяет найденные секреты маркером, как redactSensitiveData.
+func redactCredentials(text string, entropy entropySettings) string {
+	redacted := text
+	for _, format := range credentialFormats {
+		redacted = format.re.ReplaceAllString(redacted, sensitiveReplacement)
+	}
+
+	tokens := entropyTokens(redacted)
+	for i := len(tokens) - 1; i >= 0; i-- {
+		token := tokens[i]
+		parts := slices.Clone(token.parts)
+		replaced := false
+		for j, part := range parts {
+			if isHighEntropyToken(part, entropy) {
+				parts[j] = sensitiveReplacement
+				replaced = true
+			}
+		}
+		if !replaced && token.whole && isHighEntropyToken(redacted[token.start:token.end], entropy) {
+			parts = []string{sensitiveReplacement}
+		}
+		redacted = redacted[:token.start] + strings.Join(parts, "/") + redacted[token.end:]
+	}
+	return redacted
+}
+
+// entropyToken — токен-кандидат и части, энтропия которых оценивается.
+// whole означает, что токен оценивается еще и целиком.
+type entropyToken struct {
+	start, end int
+	parts      []string
+	whole      bool
+}
+
+// entropyTokens делит токены со слешами на сегменты: путь из обычных слов вроде
+// /var/lib/service/data/cache целиком набирает высокую энтропию, хотя секрета
+// в нем нет. Только сегменты оцениваются у настоящих путей: токенов внутри URL
+// со схемой и токенов, которые начинаются со слеша и состоят из обычных слов.
+// Слеш входит в алфавит base64, поэтому остальные токены
+// (wJalrXUtnFEMI/K7MDENG/bPxRfiCY...) оцениваются еще и целиком.
+func entropyTokens(text string) []entropyToken {
+	urls := urlWithScheme.FindAllStringIndex(text, -1)
+	inURL := func(pos int) bool {
+		return slices.ContainsFunc(urls, func(span []int) bool { return span[0] <= pos && pos < span[1] })
+	}
+
+	var tokens []entropyToken
+	for _, span := range entropyCandidate.FindAllStringIndex(text, -1) {
+		token := text[span[0]:span[1]]
+		if !strings.Contains(token, "/") {
+			tokens = append(tokens, entropyToken{start: span[0], end: span[1], parts: []string{token}})
+			continue
+		}
+		tokens = append(tokens, entropyToken{
+			start: span[0],
+			end:   span[1],
+			parts: strings.Split(token, "/"),
+			whole: !inURL(span[0]) && !isPlainPath(token),
+		})
+	}
+	return tokens
+}
+
+func isPlainPath(token string) bool {
+	rest, ok := strings.CutPrefix(token, "/")
+	if !ok {
+		return false
+	}
+	for _, segment := range strings.Split(rest, "/") {
+		if !pathSegment.MatchString(segment) {
+			return false
+		}
+	}
+	return true
+}
+
+// isHighEntropyToken отсекает обычные слова и идентификаторы: секрет должен быть
+// достаточно длинным, содержать и буквы, и цифры и иметь высокую энтропию Шеннона.
+func isHighEntropyToken(token string, entropy entropySettings) bool {
+	if len(token) < entropy.minLength {
+		return false
+	}
+
+	var hasLetter, hasDigit bool
+	for _, r := range token {
+		hasLetter = hasLetter || unicode.IsLetter(r)
+		hasDigit = hasDigit || unicode.IsDigit(r)
+	}
+	if !hasLetter || !hasDigit {
+		return false
+	}
+
+	return shannonEntropy(strings.Trim(token, "=")) >= entropy.threshold
+}
+
+// shannonEntropy считает энтропию строки в битах на символ.
+func shannonEntropy(s string) float64 {
+	if s == "" {
+		return 0
+	}
+
+	counts := make(map[rune]int)
+	total := 0
+	for _, r := range s {
+		counts[r]++
+		total++
+	}
+
+	var entropy float64
+	for _, count := range counts {
+		p := float64(count) / float64(total)
+		entropy -= p * math.Log2(p)
+	}
+	return entropy
+}
@@ -0,0 +1,184 @@
+package analyzer
+
+import (
+	"go/ast"
+	"go/token"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Директивы подавления. Формат: //logmsglint:ignore no-specials,LML004 -- причина.
+// Как и у других директив Go, пробела после // быть не должно.
+const (
+	ignoreDirective     = "logmsglint:ignore"
+	fileIgnoreDirective = "logmsglint:file-ignore"
+	directiveReasonSep  = "--"
+)
+
+// directive — разобранная директива подавления и область кода, к которой она относится.
+type directive struct {
+	pos token.Pos
+	// from и to — границы подавления: узел, к которому относится директива,
+	// или весь файл для file-ignore.
+	from, to token.Pos
+	// rules — идентификаторы правил в порядке перечисления и отметка,
+	// подавила ли директива хотя бы одну диагностику этого правила.
+	rules []string
+	used  map[string]bool
+}
+
+// collectDirectives разбирает директивы во всех файлах пакета. Некорректные
+// директивы ничего не подавляют: о них сразу сообщается по правилу directive.
+func collectDirectives(pass *analysis.Pass, cfg *settings) []*directive {
+	var directives []*directive
+	for _, file := range pass.Files {
+		var lines *fileLines
+		for _, group := range file.Comments {
+			for _, comment := range group.List {
+				name, body, ok := parseDirectiveName(comment.Text)
+				if !ok {
+					continue
+				}
+
+				rules, ok := parseDirectiveBody(pass, cfg, comment, body)
+				if !ok {
+					continue
+				}
+
+				d := &directive{pos: comment.Pos(), from: file.FileStart, to: file.FileEnd, rules: rules, used: make(map[string]bool, len(rules))}
+				if name == ignoreDirective {
+					if lines == nil {
+						lines = collectFileLines(pass.Fset, file)
+					}
+					d.from, d.to = lines.directiveScope(pass.Fset, comment)
+				}
+				directives = append(directives, d)
+			}
+		}
+	}
+	return directives
+}
+
+func parseDirectiveName(text string) (string, string, bool) {
+	text, ok := strings.CutPrefix(text, "//")
+	if !ok {
+		return "", "", false
+	}
+
+	for _, name := range []string{fileIgnoreDirective, ignoreDirective} {
+		rest, ok := strings.CutPrefix(text, name)
+		if ok && (rest == "" || rest[0] == ' ' || rest[0] == '\t') {
+			// Все после следующего "//" — отдельный комментарий, а не часть директивы.
+			rest, _, _ = strings.Cut(rest, "//")
+			return name, rest, true
+		}
+	}
+	return "", "", false
+}
+
+// parseDirectiveBody проверяет список правил и обязательную причину после "--".
+func parseDirectiveBody(pass *analysis.Pass, cfg *settings, comment *ast.Comment, body string) ([]string, bool) {
+	list, reason, found := strings.Cut(body, directiveReasonSep)
+	if !found || strings.TrimSpace(reason) == "" {
+		cfg.reportDirective(pass, comment.Pos(), cfg.msgs.text(diagDirectiveNoReason))
+		return nil, false
+	}
+
+	ids := strings.FieldsFunc(list, func(r rune) bool { return r == ',' || r == ' ' || r == '\t' })
+	if len(ids) == 0 {
+		cfg.reportDirective(pass, comment.Pos(), cfg.msgs.text(diagDirectiveNoRules))
+		return nil, false
+	}
+
+	rules := make([]string, 0, len(ids))
+	for _, id := range ids {
+		rule, ok := resolveRule(id)
+		if !ok {
+			cfg.reportDirective(pass, comment.Pos(), cfg.msgs.sprintf(diagDirectiveUnknownRule, id))
+			return nil, false
+		}
+		rules = append(rules, rule)
+	}
+	return rules, true
+}
+
+// fileLines — узлы файла по строкам: starts хранит самый внешний узел, который
+// начинается на строке, ends — самый внутренний многострочный узел, который на ней
+// заканчивается (например, вызов, закрытый скобкой на отдельной строке).
+type fileLines struct {
+	starts map[int]ast.Node
+	ends   map[int]ast.Node
+}
+
+func collectFileLines(fset *token.FileSet, file *ast.File) *fileLines {
+	lines := &fileLines{starts: make(map[int]ast.Node), ends: make(map[int]ast.Node)}
+	ast.Inspect(file, func(node ast.Node) bool {
+		switch node.(type) {
+		case nil, *ast.File, *ast.CommentGroup, *ast.Comment:
+			return true
+		}
+		start, end := fset.Position(node.Pos()).Line, fset.Position(node.End()).Line
+		if _, exists := lines.starts[start]; !exists {
+			lines.starts[start] = node
+		}
+		// Обход идет от внешних узлов к внутренним, поэтому последний
+		// записанный узел — самый внутренний.
+		if end > start {
+			lines.ends[end] = node
+		}
+		return true
+	})
+	return lines
+}
+
+// directiveScope определяет, к чему относится директива. Комментарий в конце
+// строки относится к узлу, который на ней начинается, а если строка только
+// закрывает узел — к самому внутреннему такому узлу: многострочный вызов
+// подавляется целиком. Комментарий на отдельной строке относится к узлу
+// на следующей строке.
+func (l *fileLines) directiveScope(fset *token.FileSet, comment *ast.Comment) (token.Pos, token.Pos) {
+	line := fset.Position(comment.Pos()).Line
+	if node, ok := l.starts[line]; ok && node.Pos() < comment.Pos() {
+		return node.Pos(), node.End()
+	}
+	if node, ok := l.ends[line]; ok && node.End() <= comment.Pos() {
+		return node.Pos(), node.End()
+	}
+	if node, ok := l.starts[line+1]; ok {
+		return node.Pos(), node.End()
+	}
+	return token.NoPos, token.NoPos
+}
+
+// suppress сообщает, подавлена ли диагностика правила, и отмечает директиву использованной.
+func (s *settings) suppress(rule string, pos token.Pos) bool {
+	suppressed := false
+	for _, d := range s.directives {
+		if pos < d.from || pos >= d.to {
+			continue
+		}
+		if slices.Contains(d.rules, rule) {
+			d.used[rule] = true
+			suppressed = true
+		}
+	}
+	return suppressed
+}
+
+// reportUnusedDirectives сообщает о правилах в директивах, которые ничего
+// не подавили: такие директивы остались после исправления кода.
+func (s *settings) reportUnusedDirectives(pass *analysis.Pass) {
+	for _, d := range s.directives {
+		for _, rule := range d.rules {
+			if !d.used[rule] {
+				s.reportDirective(pass, d.pos, s.msgs.sprintf(diagDirectiveUnused, rule))
+			}
+		}
+	}
+}
+
+func (s *settings) reportDirective(pass *analysis.Pass, pos token.Pos, message string) {
+	s.report(pass, ruleDirective, analysis.Diagnostic{Pos: pos, Message: message})
+}
@@ -0,0 +1,194 @@
+//go:build ignore
+
+// gen_emoji генерирует emoji_tables.go из emoji-data.txt (Unicode Technical Standard #51).
+//
+// Запуск: go generate ./pkg/analyzer или, без сети, с локальной копией файла:
+//
+//	go run gen_emoji.go -data /path/to/emoji-data.txt
+package main
+
+import (
+	"bufio"
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"log"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+const emojiDataURL = "https://www.unicode.org/Public/16.0.0/ucd/emoji/emoji-data.txt"
+
+// properties связывает свойства из emoji-data.txt с именами таблиц в пакете.
+var properties = []struct {
+	name  string
+	table string
+	doc   string
+}{
+	{name: "Emoji_Presentation", table: "emojiPresentationDefault", doc: "символы, которые по умолчанию показываются как эмодзи"},
+	{name: "Emoji", table: "emojiCharacter", doc: "символы, которые становятся эмодзи с VS16 или модификатором цвета кожи"},
+	{name: "Extended_Pictographic", table: "emojiPictographic", doc: "пиктограммы, которыми продолжаются ZWJ-последовательности"},
+	{name: "Emoji_Modifier", table: "emojiModifier", doc: "модификаторы цвета кожи"},
+	{name: "Emoji_Component", table: "emojiComponent", doc: "части эмодзи-последовательностей, включая ZWJ, VS16, keycap, флаги и теги"},
+}
+
+type codeRange struct{ lo, hi uint32 }
+
+func main() {
+	data := flag.String("data", "", "локальный emoji-data.txt (по умолчанию скачивается "+emojiDataURL+")")
+	out := flag.String("o", "emoji_tables.go", "файл для результата")
+	flag.Parse()
+
+	src, err := openData(*data)
+	if err != nil {
+		log.Fatal(err)
+	}
+	defer src.Close()
+
+	version, ranges, err := parse(src)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	code, err := render(version, ranges)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(*out, code, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func openData(path string) (io.ReadCloser, error) {
+	if path != "" {
+		return os.Open(path)
+	}
+
+	resp, err := http.Get(emojiDataURL)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("GET %s: %s", emojiDataURL, resp.Status)
+	}
+	return resp.Body, nil
+}
+
+// parse читает строки вида "1F3FB..1F3FF ; Emoji_Modifier # ..." и версию
+// из заголовка "# Version: 16.0".
+func parse(r io.Reader) (string, map[string][]codeRange, error) {
+	version := ""
+	ranges := make(map[string][]codeRange)
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if v, ok := strings.CutPrefix(line, "# Version:"); ok {
+			version = strings.TrimSpace(v)
+		}
+		line, _, _ = strings.Cut(line, "#")
+		codes, property, ok := strings.Cut(line, ";")
+		if !ok {
+			continue
+		}
+		property = strings.TrimSpace(property)
+
+		lo, hi, found := strings.Cut(strings.TrimSpace(codes), "..")
+		if !found {
+			hi = lo
+		}
+		loCode, err := strconv.ParseUint(lo, 16, 32)
+		if err != nil {
+			return "", nil, fmt.Errorf("строка %q: %w", scanner.Text(), err)
+		}
+		hiCode, err := strconv.ParseUint(hi, 16, 32)
+		if err != nil {
+			return "", nil, fmt.Errorf("строка %q: %w", scanner.Text(), err)
+		}
+		ranges[property] = append(ranges[property], codeRange{lo: uint32(loCode), hi: uint32(hiCode)})
+	}
+	if err := scanner.Err(); err != nil {
+		return "", nil, err
+	}
+
+	for _, p := range properties {
+		if len(ranges[p.name]) == 0 {
+			return "", nil, fmt.Errorf("в файле нет свойства %s", p.name)
+		}
+	}
+	return version, ranges, nil
+}
+
+func render(version string, ranges map[string][]codeRange) ([]byte, error) {
+	var b bytes.Buffer
+	fmt.Fprintf(&b, "// Code generated by gen_emoji.go from emoji-data.txt (Unicode %s). DO NOT EDIT.\n\n", version)
+	b.WriteString("package analyzer\n\nimport \"unicode\"\n")
+
+	for _, p := range properties {
+		r16, r32, latinOffset := split(merge(ranges[p.name]))
+
+		fmt.Fprintf(&b, "\n// %s — свойство %s (%s).\n", p.table, p.name, p.doc)
+		fmt.Fprintf(&b, "var %s = &unicode.RangeTable{\n", p.table)
+		if len(r16) > 0 {
+			b.WriteString("R16: []unicode.Range16{\n")
+			for _, r := range r16 {
+				fmt.Fprintf(&b, "{Lo: 0x%04X, Hi: 0x%04X, Stride: 1},\n", r.lo, r.hi)
+			}
+			b.WriteString("},\n")
+		}
+		if len(r32) > 0 {
+			b.WriteString("R32: []unicode.Range32{\n")
+			for _, r := range r32 {
+				fmt.Fprintf(&b, "{Lo: 0x%X, Hi: 0x%X, Stride: 1},\n", r.lo, r.hi)
+			}
+			b.WriteString("},\n")
+		}
+		if latinOffset > 0 {
+			fmt.Fprintf(&b, "LatinOffset: %d,\n", latinOffset)
+		}
+		b.WriteString("}\n")
+	}
+
+	return format.Source(b.Bytes())
+}
+
+// merge сортирует диапазоны и склеивает соседние: в emoji-data.txt одно свойство
+// разбито на строки по версиям эмодзи.
+func merge(ranges []codeRange) []codeRange {
+	slices.SortFunc(ranges, func(a, b codeRange) int { return int(a.lo) - int(b.lo) })
+
+	var merged []codeRange
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && r.lo <= merged[n-1].hi+1 {
+			merged[n-1].hi = max(merged[n-1].hi, r.hi)
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// split раскладывает диапазоны по R16 и R32, как того требует unicode.RangeTable.
+func split(ranges []codeRange) (r16, r32 []codeRange, latinOffset int) {
+	for _, r := range ranges {
+		switch {
+		case r.hi <= 0xFFFF:
+			r16 = append(r16, r)
+			if r.hi <= 0xFF {
+				latinOffset++
+			}
+		case r.lo > 0xFFFF:
+			r32 = append(r32, r)
+		default:
+			r16 = append(r16, codeRange{lo: r.lo, hi: 0xFFFF})
+			r32 = append(r32, codeRange{lo: 0x10000, hi: r.hi})
+		}
+	}
+	return r16, r32, latinOffset
+}
@@ -0,0 +1,390 @@
+package analyzer
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Языки диагностик и ошибок конфигурации.
+const (
+	LanguageEnglish = "en"
+	LanguageRussian = "ru"
+
+	defaultLanguage = LanguageEnglish
+)
+
+// messageID — ключ сообщения в каталоге. Тексты диагностик, заголовки автофиксов
+// и ошибки конфигурации хранятся только в каталогах.
+type messageID int
+
+const (
+	diagStartLower messageID = iota
+	diagEnglishOnly
+	diagNoSpecials
+	diagSensitive
+	diagSensitiveKey
+	diagSensitiveArg
+	diagTainted
+	diagSensitiveStruct
+	diagCredential
+	diagPII
+	diagDirectiveNoReason
+	diagDirectiveNoRules
+	diagDirectiveUnknownRule
+	diagDirectiveUnused
+	diagBaselineFixed
+
+	msgAnalyzerDoc
+	msgFixTitle
+	msgRelatedConstant
+	msgHighEntropy
+	msgPIIEmail
+	msgPIIIP
+	msgPIIPhone
+	msgPIICard
+	msgTaintSourceCall
+	msgTaintSourceKeyed
+	msgTaintTaggedField
+	msgTaintField
+	msgTaintFlows
+	msgTaintStored
+	msgTaintAppended
+	msgTaintPassesThrough
+
+	msgRuleLowercase
+	msgRuleEnglishOnly
+	msgRuleNoSpecials
+	msgRuleSensitive
+	msgRuleSensitiveKey
+	msgRuleSensitiveArg
+	msgRuleSecretStruct
+	msgRuleCredential
+	msgRulePII
+	msgRuleTaint
+	msgRuleDirective
+	msgRuleBaseline
+
+	errInvalidConfigType
+	errInvalidSensitiveRegex
+	errExpectedStringSlice
+	errExpectedStringListItem
+	errExpectedSinkMap
+	errInvalidSinkName
+	errInvalidSinkIndex
+	errInvalidSecretTag
+	errExpectedBool
+	errExpectedString
+	errExpectedNumber
+	errInvalidEntropy
+	errUnknownRule
+	errInvalidSeverity
+	errUnknownLanguage
+	errUnknownScript
+	errInvalidPunctuation
+	errInvalidSensitiveAllow
+	errUnknownSensitive
+	errInvalidBaseline
+	errPluginConfig
+	errPluginInit
+
+	errfExpectedMap
+	errfKey
+	errfKeyGotType
+	errfKeyGotValue
+	errfRuleExpectedMap
+	errfRuleKeyGotType
+	errfGotType
+	errfUnknownRule
+	errfInvalidSeverity
+	errfInit
+	errfQuoted
+	errfQuotedValue
+	errfType
+	errfInvalidRegex
+	errfInvalidEntropy
+	errfWrap
+
+	messageCount
+)
+
+// catalog — тексты сообщений одного языка.
+type catalog map[messageID]string
+
+// catalogs содержит все поддерживаемые языки. Форматы без слов (errfQuoted и др.)
+// есть только в английском каталоге: остальные языки берут их оттуда.
+var catalogs = map[string]catalog{
+	LanguageEnglish: {
+		diagStartLower:      "log message must start with a lowercase English letter",
+		diagEnglishOnly:     "log message must contain only English text (Cyrillic and other scripts are not allowed)",
+		diagNoSpecials:      "log message must not contain special characters (!, ?, ...) or emoji",
+		diagSensitive:       "log message contains potentially sensitive data",
+		diagSensitiveKey:    "log attribute key contains potentially sensitive data",
+		diagSensitiveArg:    "log argument contains potentially sensitive data",
+		diagTainted:         "value from a secret source reaches the log",
+		diagSensitiveStruct: "struct with a sensitive field is logged as a whole",
+		diagCredential:      "log message contains a literal that looks like a key or token",
+		diagPII:             "log message contains personal data",
+
+		diagDirectiveNoReason:    `suppression directive must give a reason after "--"`,
+		diagDirectiveNoRules:     "suppression directive must list rule IDs",
+		diagDirectiveUnknownRule: "suppression directive refers to unknown rule %q",
+		diagDirectiveUnused:      "suppression directive for %s does not suppress anything",
+		diagBaselineFixed:        "baseline has %d finding(s) of rule %s (hash %s) that no longer occur; regenerate the baseline",
+
+		msgAnalyzerDoc:        "checks log message text in log, slog, zap, zerolog and logrus",
+		msgFixTitle:           "fix log message",
+		msgRelatedConstant:    "message is defined by constant %s",
+		msgHighEntropy:        "high-entropy string",
+		msgPIIEmail:           "email",
+		msgPIIIP:              "IP address",
+		msgPIIPhone:           "phone number",
+		msgPIICard:            "card number",
+		msgTaintSourceCall:    "secret obtained from %s",
+		msgTaintSourceKeyed:   "secret obtained from %s(%q)",
+		msgTaintTaggedField:   "secret read from field %s tagged %s",
+		msgTaintField:         "secret read from field %s",
+		msgTaintFlows:         "value with a secret is passed on",
+		msgTaintStored:        "secret is stored here",
+		msgTaintAppended:      "secret is appended to a slice",
+		msgTaintPassesThrough: "secret passes through %s",
+
+		msgRuleLowercase:    "Message starts with a lowercase letter",
+		msgRuleEnglishOnly:  "English text only",
+		msgRuleNoSpecials:   "No special characters or emoji",
+		msgRuleSensitive:    "Sensitive data keywords",
+		msgRuleSensitiveKey: "Structured attribute keys",
+		msgRuleSensitiveArg: "Arguments with secret names",
+		msgRuleSecretStruct: "Structs with secret fields",
+		msgRuleCredential:   "Keys and tokens in the message text",
+		msgRulePII:          "Personal data",
+		msgRuleTaint:        "Values from secret sources",
+		msgRuleDirective:    "Suppression directives",
+		msgRuleBaseline:     "Stale baseline entries",
+
+		errInvalidConfigType:      "invalid configuration type",
+		errInvalidSensitiveRegex:  "invalid sensitive data pattern",
+		errExpectedStringSlice:    "expected a list of strings",
+		errExpectedStringListItem: "list item is not a string",
+		errExpectedSinkMap:        `expected a map of "package.Function": message index`,
+		errInvalidSinkName:        "invalid logging function name",
+		errInvalidSinkIndex:       "invalid message argument index",
+		errInvalidSecretTag:       `invalid secret field tag, expected key:"value"`,
+		errExpectedBool:           "expected a boolean",
+		errExpectedString:         "expected a string",
+		errExpectedNumber:         "expected a number",
+		errInvalidEntropy:         "invalid entropy search parameters",
+		errUnknownRule:            "unknown rule",
+		errInvalidSeverity:        "invalid rule severity",
+		errUnknownLanguage:        "unknown language",
+		errUnknownScript:          "unknown Unicode script",
+		errInvalidPunctuation:     "forbidden punctuation must be non-empty and contain no letters, digits or spaces",
+		errInvalidSensitiveAllow:  "invalid sensitive data exception",
+		errUnknownSensitive:       "exception refers to an unknown sensitive data pattern",
+		errInvalidBaseline:        "invalid baseline file",
+		errPluginConfig:           "failed to parse plugin configuration",
+		errPluginInit:             "failed to create analyzer",
+
+		errfExpectedMap:     "%w: expected a map, got %T",
+		errfKey:             "key %q: %w",
+		errfKeyGotType:      "key %q: %w: got %T",
+		errfKeyGotValue:     "key %q: %w: got %v",
+		errfRuleExpectedMap: "rule %q: %w: expected a map, got %T",
+		errfRuleKeyGotType:  "rule %q, key %q: %w: got %T",
+		errfGotType:         "%w: got %T",
+		errfUnknownRule:     "%w: %q (available: %s)",
+		errfInvalidSeverity: "%w: %q for rule %q (available: %s)",
+		errfInit:            "internal analyzer initialization error: %w",
+		errfQuoted:          "%w: %q",
+		errfQuotedValue:     "%w: %q: %v",
+		errfType:            "%w: %T",
+		errfInvalidRegex:    "%w %q: %w",
+		errfInvalidEntropy:  "%w: threshold=%v, min-length=%d",
+		errfWrap:            "%w: %w",
+	},
+	LanguageRussian: {
+		diagStartLower:      "лог-сообщение должно начинаться со строчной английской буквы",
+		diagEnglishOnly:     "лог-сообщение должно содержать только английский текст (кириллица и другие алфавиты запрещены)",
+		diagNoSpecials:      "лог-сообщение не должно содержать спецсимволы (!, ?, ...) и эмодзи",
+		diagSensitive:       "лог-сообщение содержит потенциально чувствительные данные",
+		diagSensitiveKey:    "ключ атрибута лога содержит потенциально чувствительные данные",
+		diagSensitiveArg:    "аргумент лога содержит потенциально чувствительные данные",
+		diagTainted:         "в лог попадает значение из источника секретов",
+		diagSensitiveStruct: "в лог целиком передается структура с чувствительным полем",
+		diagCredential:      "лог-сообщение содержит литерал, похожий на ключ или токен",
+		diagPII:             "лог-сообщение содержит персональные данные",
+
+		diagDirectiveNoReason:    `в директиве подавления нужна причина после "--"`,
+		diagDirectiveNoRules:     "в директиве подавления нужно перечислить правила",
+		diagDirectiveUnknownRule: "директива подавления ссылается на неизвестное правило %q",
+		diagDirectiveUnused:      "директива подавления для %s ничего не подавляет",
+		diagBaselineFixed:        "в baseline есть находки правила %[2]s (хеш %[3]s), которых больше нет: %[1]d; пересоздайте baseline",
+
+		msgAnalyzerDoc:        "проверяет текст лог-сообщений в log, slog, zap, zerolog и logrus",
+		msgFixTitle:           "исправить сообщение логирования",
+		msgRelatedConstant:    "сообщение задано константой %s",
+		msgHighEntropy:        "строка с высокой энтропией",
+		msgPIIEmail:           "email",
+		msgPIIIP:              "IP-адрес",
+		msgPIIPhone:           "номер телефона",
+		msgPIICard:            "номер карты",
+		msgTaintSourceCall:    "секрет получен из %s",
+		msgTaintSourceKeyed:   "секрет получен из %s(%q)",
+		msgTaintTaggedField:   "секрет прочитан из поля %s с тегом %s",
+		msgTaintField:         "секрет прочитан из поля %s",
+		msgTaintFlows:         "значение с секретом передается дальше",
+		msgTaintStored:        "секрет сохраняется здесь",
+		msgTaintAppended:      "секрет добавляется в слайс",
+		msgTaintPassesThrough: "секрет проходит через %s",
+
+		msgRuleLowercase:    "Сообщение начинается со строчной буквы",
+		msgRuleEnglishOnly:  "Только английский текст",
+		msgRuleNoSpecials:   "Без спецсимволов и эмодзи",
+		msgRuleSensitive:    "Ключевые слова чувствительных данных",
+		msgRuleSensitiveKey: "Ключи структурированных атрибутов",
+		msgRuleSensitiveArg: "Аргументы с секретными именами",
+		msgRuleSecretStruct: "Структуры с секретными полями",
+		msgRuleCredential:   "Ключи и токены в тексте сообщения",
+		msgRulePII:          "Персональные данные",
+		msgRuleTaint:        "Значения из источников секретов",
+		msgRuleDirective:    "Директивы подавления",
+		msgRuleBaseline:     "Устаревшие записи baseline",
+
+		errInvalidConfigType:      "неверный тип конфигурации",
+		errInvalidSensitiveRegex:  "невалидный паттерн чувствительных данных",
+		errExpectedStringSlice:    "ожидался список строк",
+		errExpectedStringListItem: "элемент списка не является строкой",
+		errExpectedSinkMap:        `ожидалась map вида "пакет.Функция": индекс сообщения`,
+		errInvalidSinkName:        "невалидное имя функции логирования",
+		errInvalidSinkIndex:       "невалидный индекс аргумента сообщения",
+		errInvalidSecretTag:       `невалидный тег секретного поля, ожидалось key:"value"`,
+		errExpectedBool:           "ожидалось булево значение",
+		errExpectedString:         "ожидалась строка",
+		errExpectedNumber:         "ожидалось число",
+		errInvalidEntropy:         "невалидные параметры поиска по энтропии",
+		errUnknownRule:            "неизвестное правило",
+		errInvalidSeverity:        "невалидный уровень правила",
+		errUnknownLanguage:        "неизвестный язык",
+		errUnknownScript:          "неизвестная письменность Unicode",
+		errInvalidPunctuation:     "запрещенный знак должен быть непустым и не содержать букв, цифр и пробелов",
+		errInvalidSensitiveAllow:  "невалидное исключение для чувствительных данных",
+		errUnknownSensitive:       "исключение ссылается на неизвестный паттерн чувствительных данных",
+		errInvalidBaseline:        "невалидный файл baseline",
+		errPluginConfig:           "не удалось разобрать конфигурацию плагина",
+		errPluginInit:             "не удалось создать анализатор",
+
+		errfExpectedMap:     "%w: ожидалась map-конфигурация, получено %T",
+		errfKey:             "ключ %q: %w",
+		errfKeyGotType:      "ключ %q: %w: получено %T",
+		errfKeyGotValue:     "ключ %q: %w: получено %v",
+		errfRuleExpectedMap: "правило %q: %w: ожидалась map-конфигурация, получено %T",
+		errfRuleKeyGotType:  "правило %q, ключ %q: %w: получено %T",
+		errfGotType:         "%w: получено %T",
+		errfUnknownRule:     "%w: %q (доступны: %s)",
+		errfInvalidSeverity: "%w: %q у правила %q (доступны: %s)",
+		errfInit:            "внутренняя ошибка инициализации анализатора: %w",
+		errfInvalidEntropy:  "%w: threshold=%v, min-length=%d",
+	},
+}
+
+// lookupCatalog возвращает каталог языка; пустой язык означает английский.
+func lookupCatalog(language string) (catalog, error) {
+	language = strings.ToLower(strings.TrimSpace(language))
+	if language == "" {
+		language = defaultLanguage
+	}
+
+	c, ok := catalogs[language]
+	if !ok {
+		return nil, newError(errfQuoted, ErrUnknownLanguage, language)
+	}
+	return c, nil
+}
+
+// text возвращает сообщение, при отсутствии перевода — английское.
+func (c catalog) text(id messageID) string {
+	if text, ok := c[id]; ok {
+		return text
+	}
+	return catalogs[defaultLanguage][id]
+}
+
+func (c catalog) sprintf(id messageID, args ...any) string {
+	return fmt.Sprintf(c.text(id), args...)
+}
+
+// catalogError — ошибка, текст которой берется из каталога в момент вывода.
+// Сентинел-ошибки (ErrInvalidSinkName и др.) — это catalogError без аргументов,
+// поэтому errors.Is работает одинаково для любого языка.
+type catalogError struct {
+	id       messageID
+	args     []any
+	language string
+}
+
+func newError(id messageID, args ...any) error {
+	return &catalogError{id: id, args: args}
+}
+
+func (e *catalogError) Error() string {
+	return e.render(e.language)
+}
+
+func (e *catalogError) render(language string) string {
+	c, err := lookupCatalog(language)
+	if err != nil {
+		c = catalogs[defaultLanguage]
+	}
+
+	args := make([]any, len(e.args))
+	for i, arg := range e.args {
+		if nested, ok := arg.(*catalogError); ok {
+			arg = errors.New(nested.render(language))
+		}
+		args[i] = arg
+	}
+
+	// fmt.Errorf, а не Sprintf: в форматах каталога используется %w.
+	return fmt.Errorf(c.text(e.id), args...).Error()
+}
+
+// Is сравнивает сентинел-ошибки по идентификатору сообщения: локализованная
+// копия сентинела должна совпадать с исходной переменной.
+func (e *catalogError) Is(target error) bool {
+	t, ok := target.(*catalogError)
+	return ok && len(t.args) == 0 && len(e.args) == 0 && t.id == e.id
+}
+
+func (e *catalogError) Unwrap() []error {
+	var wrapped []error
+	for _, arg := range e.args {
+		if err, ok := arg.(error); ok {
+			wrapped = append(wrapped, err)
+		}
+	}
+	return wrapped
+}
+
+// WrapError дополняет ошибку конфигурации сентинелом точки входа, например
+// ErrPluginConfig. Сентинел выводится на языке исходной ошибки: язык из
+// настроек известен только после их разбора. errors.Is находит обе ошибки.
+func WrapError(sentinel, err error) error {
+	wrapped := &catalogError{id: errfWrap, args: []any{sentinel, err}}
+	var cause *catalogError
+	if errors.As(err, &cause) {
+		wrapped.language = cause.language
+	}
+	return wrapped
+}
+
+// localizeError выводит ошибку конфигурации на выбранном языке.
+func localizeError(err error, language string) error {
+	ce, ok := err.(*catalogError)
+	if !ok {
+		return err
+	}
+
+	localized := *ce
+	localized.language = language
+	return &localized
+}
@@ -0,0 +1,231 @@
+package analyzer
+
+import (
+	"net/netip"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// piiDetector ищет в тексте персональные данные одного вида
+// и возвращает диапазоны найденных значений.
+type piiDetector struct {
+	name messageID
+	find func(text string, allow *piiAllowlist) [][2]int
+}
+
+var (
+	piiEmailDetector = piiDetector{name: msgPIIEmail, find: findEmails}
+	piiIPDetector    = piiDetector{name: msgPIIIP, find: findIPs}
+	piiPhoneDetector = piiDetector{name: msgPIIPhone, find: findPhones}
+	piiCardDetector  = piiDetector{name: msgPIICard, find: findCards}
+)
+
+var (
+	emailRe     = regexp.MustCompile(`[A-Za-z0-9._%+-]+@((?:[A-Za-z0-9-]+\.)+[A-Za-z]{2,})`)
+	ipv4Re      = regexp.MustCompile(`\b\d{1,3}(?:\.\d{1,3}){3}\b`)
+	ipv6Re      = regexp.MustCompile(`[0-9A-Fa-f]*:[0-9A-Fa-f:.]*`)
+	e164PhoneRe = regexp.MustCompile(`\+[1-9]\d{7,14}\b`)
+	cardRe      = regexp.MustCompile(`\b\d(?:[ -]?\d){12,18}\b`)
+)
+
+// piiDocumentationDomains и piiDocumentationPrefixes зарезервированы для
+// документации и примеров (RFC 2606, RFC 5737, RFC 3849), поэтому разрешены всегда.
+var (
+	piiDocumentationDomains  = []string{"example.com", "example.org", "example.net", "example", "test", "invalid", "localhost"}
+	piiDocumentationPrefixes = []string{"192.0.2.0/24", "198.51.100.0/24", "203.0.113.0/24", "2001:db8::/32", "127.0.0.0/8", "::1/128"}
+)
+
+// piiAllowlist — значения, которые не считаются персональными данными.
+type piiAllowlist struct {
+	domains  []string
+	prefixes []netip.Prefix
+	values   map[string]struct{}
+}
+
+// piiSettings — включенные детекторы и разрешенные значения.
+type piiSettings struct {
+	detectors []piiDetector
+	allow     *piiAllowlist
+}
+
+func compilePII(cfg PIIConfig) piiSettings {
+	var detectors []piiDetector
+	if cfg.Email {
+		detectors = append(detectors, piiEmailDetector)
+	}
+	if cfg.IP {
+		detectors = append(detectors, piiIPDetector)
+	}
+	if cfg.Phone {
+		detectors = append(detectors, piiPhoneDetector)
+	}
+	if cfg.Card {
+		detectors = append(detectors, piiCardDetector)
+	}
+
+	allow := &piiAllowlist{
+		domains:  append([]string(nil), piiDocumentationDomains...),
+		prefixes: make([]netip.Prefix, 0, len(piiDocumentationPrefixes)),
+		values:   make(map[string]struct{}),
+	}
+	for _, raw := range piiDocumentationPrefixes {
+		allow.prefixes = append(allow.prefixes, netip.MustParsePrefix(raw))
+	}
+
+	// Элемент allow может быть подсетью, адресом, доменом или точным значением.
+	for _, raw := range cfg.Allow {
+		item := strings.ToLower(strings.TrimSpace(raw))
+		if item == "" {
+			continue
+		}
+		if prefix, err := netip.ParsePrefix(item); err == nil {
+			allow.prefixes = append(allow.prefixes, prefix.Masked())
+			continue
+		}
+		if addr, err := netip.ParseAddr(item); err == nil {
+			allow.prefixes = append(allow.prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+		allow.domains = append(allow.domains, strings.TrimPrefix(item, "@"))
+		allow.values[item] = struct{}{}
+	}
+
+	return piiSettings{detectors: detectors, allow: allow}
+}
+
+func (a *piiAllowlist) allowsValue(value string) bool {
+	_, ok := a.values[strings.ToLower(value)]
+	return ok
+}
+
+func (a *piiAllowlist) allowsDomain(domain string) bool {
+	domain = strings.ToLower(domain)
+	for _, allowed := range a.domains {
+		if domain == allowed || strings.HasSuffix(domain, "."+allowed) {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *piiAllowlist) allowsAddr(addr netip.Addr) bool {
+	if addr.IsUnspecified() {
+		return true
+	}
+	for _, prefix := range a.prefixes {
+		if prefix.Contains(addr.Unmap()) {
+			return true
+		}
+	}
+	return false
+}
+
+func findEmails(text string, allow *piiAllowlist) [][2]int {
+	var spans [][2]int
+	for _, m := range emailRe.FindAllStringSubmatchIndex(text, -1) {
+		value, domain := text[m[0]:m[1]], text[m[2]:m[3]]
+		if allow.allowsDomain(domain) || allow.allowsValue(value) {
+			continue
+		}
+		spans = append(spans, [2]int{m[0], m[1]})
+	}
+	return spans
+}
+
+func findIPs(text string, allow *piiAllowlist) [][2]int {
+	var spans [][2]int
+	for _, m := range ipv4Re.FindAllStringIndex(text, -1) {
+		addr, err := netip.ParseAddr(text[m[0]:m[1]])
+		if err != nil || allow.allowsAddr(addr) || allow.allowsValue(text[m[0]:m[1]]) {
+			continue
+		}
+		spans = append(spans, [2]int{m[0], m[1]})
+	}
+
+	for _, m := range ipv6Re.FindAllStringIndex(text, -1) {
+		// Хвостовые двоеточия и точки — это пунктуация текста, а не часть адреса.
+		end := m[0] + len(strings.TrimRight(text[m[0]:m[1]], ":."))
+		value := text[m[0]:end]
+		if strings.Count(value, ":") < 2 {
+			continue
+		}
+		addr, err := netip.ParseAddr(value)
+		if err != nil || !addr.Is6() || allow.allowsAddr(addr) || allow.allowsValue(value) {
+			continue
+		}
+		spans = append(spans, [2]int{m[0], end})
+	}
+	return spans
+}
+
+func findPhones(text string, allow *piiAllowlist) [][2]int {
+	var spans [][2]int
+	for _, m := range e164PhoneRe.FindAllStringIndex(text, -1) {
+		if m[0] > 0 && isWordByte(text[m[0]-1]) {
+			continue
+		}
+		if allow.allowsValue(text[m[0]:m[1]]) {
+			continue
+		}
+		spans = append(spans, [2]int{m[0], m[1]})
+	}
+	return spans
+}
+
+func findCards(text string, allow *piiAllowlist) [][2]int {
+	var spans [][2]int
+	for _, m := range cardRe.FindAllStringIndex(text, -1) {
+		value := text[m[0]:m[1]]
+		digits := strings.NewReplacer(" ", "", "-", "").Replace(value)
+		if len(digits) < 13 || len(digits) > 19 || !luhnValid(digits) {
+			continue
+		}
+		if allow.allowsValue(value) || allow.allowsValue(digits) {
+			continue
+		}
+		spans = append(spans, [2]int{m[0], m[1]})
+	}
+	return spans
+}
+
+// luhnValid проверяет контрольную сумму номера карты по алгоритму Луна.
+func luhnValid(digits string) bool {
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return sum%10 == 0
+}
+
+func isWordByte(b byte) bool {
+	return b == '_' || ('0' <= b && b <= '9') || ('a' <= b && b <= 'z') || ('A' <= b && b <= 'Z')
+}
+
+// redactSpans заменяет диапазоны текста маркером, как redactSensitiveData.
+func redactSpans(text string, spans [][2]int) string {
+	spans = slices.Clone(spans)
+	slices.SortFunc(spans, func(a, b [2]int) int { return a[0] - b[0] })
+
+	var b strings.Builder
+	last := 0
+	for _, span := range spans {
+		if span[0] < last {
+			continue
+		}
+		b.WriteString(text[last:span[0]])
+		b.WriteString(sensitiveReplacement)
+		last = span[1]
+	}
+	b.WriteString(text[last:])
+	return b.String()
+}
@@ -0,0 +1,286 @@
+package analyzer
+
+import (
+	"embed"
+	"slices"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// Стабильные идентификаторы правил: ими правила включаются и настраиваются в rules.
+const (
+	ruleLowercase    = "lowercase"
+	ruleEnglishOnly  = "english-only"
+	ruleNoSpecials   = "no-specials"
+	ruleSensitive    = "sensitive"
+	ruleSensitiveKey = "sensitive-key"
+	ruleSensitiveArg = "sensitive-arg"
+	ruleSecretStruct = "secret-struct"
+	ruleCredential   = "credential"
+	rulePII          = "pii"
+	ruleTaint        = "taint"
+	ruleDirective    = "directive"
+	ruleBaseline     = "baseline"
+)
+
+// Уровни правил. Уровень попадает в Category диагностики после кода правила.
+const (
+	severityError   = "error"
+	severityWarning = "warning"
+	severityInfo    = "info"
+
+	defaultSeverity = severityError
+)
+
+// ruleCodes задает каждому правилу стабильный код для Category, URL и подавлений.
+// Коды не переиспользуются: новое правило получает следующий свободный номер.
+var ruleCodes = map[string]string{
+	ruleLowercase:    "LML001",
+	ruleEnglishOnly:  "LML002",
+	ruleNoSpecials:   "LML003",
+	ruleSensitive:    "LML004",
+	ruleSensitiveKey: "LML005",
+	ruleSensitiveArg: "LML006",
+	ruleSecretStruct: "LML007",
+	ruleCredential:   "LML008",
+	rulePII:          "LML009",
+	ruleTaint:        "LML010",
+	ruleDirective:    "LML011",
+	ruleBaseline:     "LML012",
+}
+
+// ruleTitles задает заголовки правил в каталоге сообщений. Заголовки совпадают
+// с заголовками документации в docs на том же языке.
+var ruleTitles = map[string]messageID{
+	ruleLowercase:    msgRuleLowercase,
+	ruleEnglishOnly:  msgRuleEnglishOnly,
+	ruleNoSpecials:   msgRuleNoSpecials,
+	ruleSensitive:    msgRuleSensitive,
+	ruleSensitiveKey: msgRuleSensitiveKey,
+	ruleSensitiveArg: msgRuleSensitiveArg,
+	ruleSecretStruct: msgRuleSecretStruct,
+	ruleCredential:   msgRuleCredential,
+	rulePII:          msgRulePII,
+	ruleTaint:        msgRuleTaint,
+	ruleDirective:    msgRuleDirective,
+	ruleBaseline:     msgRuleBaseline,
+}
+
+// ruleIDs перечисляет все правила в порядке, в котором они описаны в README.
+var ruleIDs = []string{
+	ruleLowercase,
+	ruleEnglishOnly,
+	ruleNoSpecials,
+	ruleSensitive,
+	ruleSensitiveKey,
+	ruleSensitiveArg,
+	ruleSecretStruct,
+	ruleCredential,
+	rulePII,
+	ruleTaint,
+	ruleDirective,
+	ruleBaseline,
+}
+
+// securityRules — правила, которые ищут утечки чувствительных данных.
+var securityRules = []string{
+	ruleSensitive,
+	ruleSensitiveKey,
+	ruleSensitiveArg,
+	ruleSecretStruct,
+	ruleCredential,
+	rulePII,
+	ruleTaint,
+}
+
+// ruleDocsBaseURL — адрес каталога с документацией правил в репозитории.
+// Документация каждого языка лежит в своем подкаталоге: docs/en, docs/ru.
+const ruleDocsBaseURL = "https://github.com/Inkulet/linter_go/blob/main/pkg/analyzer/docs/"
+
+//go:embed docs/en/*.md docs/ru/*.md
+var ruleDocs embed.FS
+
+// RuleDoc возвращает документацию правила по идентификатору (lowercase) или коду (LML001)
+// на языке language; неизвестный язык означает английский.
+func RuleDoc(id, language string) (string, bool) {
+	name, ok := resolveRule(id)
+	if !ok {
+		return "", false
+	}
+
+	doc, err := ruleDocs.ReadFile("docs/" + docsLanguage(language) + "/" + ruleCodes[name] + ".md")
+	if err != nil {
+		return "", false
+	}
+	return string(doc), true
+}
+
+// ruleDocURL возвращает адрес документации правила на языке language.
+func ruleDocURL(code, language string) string {
+	return ruleDocsBaseURL + docsLanguage(language) + "/" + code + ".md"
+}
+
+// docsLanguage приводит язык к подкаталогу документации. Документация есть
+// для каждого языка каталога сообщений, остальные языки получают английскую.
+func docsLanguage(language string) string {
+	language = strings.ToLower(strings.TrimSpace(language))
+	if _, ok := catalogs[language]; ok {
+		return language
+	}
+	return defaultLanguage
+}
+
+// RuleInfo описывает правило для внешних отчетов, например SARIF.
+type RuleInfo struct {
+	// Code — код правила (LML001), ID — идентификатор в rules (lowercase).
+	Code string
+	ID   string
+	// Title — заголовок правила на выбранном языке, Doc и URL — документация
+	// целиком в Markdown и ее адрес на том же языке.
+	Title string
+	Doc   string
+	URL   string
+	// Security отмечает правила, которые ищут чувствительные данные.
+	Security bool
+}
+
+// Rules возвращает описания всех правил в порядке кодов. Заголовки выводятся
+// на языке language, как и диагностики; неизвестный язык означает английский.
+func Rules(language string) []RuleInfo {
+	msgs, err := lookupCatalog(language)
+	if err != nil {
+		msgs = catalogs[defaultLanguage]
+	}
+
+	rules := make([]RuleInfo, 0, len(ruleIDs))
+	for _, id := range ruleIDs {
+		code := ruleCodes[id]
+		doc, _ := RuleDoc(id, language)
+
+		rules = append(rules, RuleInfo{
+			Code:     code,
+			ID:       id,
+			Title:    msgs.text(ruleTitles[id]),
+			Doc:      doc,
+			URL:      ruleDocURL(code, language),
+			Security: slices.Contains(securityRules, id),
+		})
+	}
+	return rules
+}
+
+// resolveRule приводит идентификатор или код правила к идентификатору.
+func resolveRule(id string) (string, bool) {
+	id = strings.TrimSpace(id)
+	if _, ok := ruleCodes[id]; ok {
+		return id, true
+	}
+	for name, code := range ruleCodes {
+		if strings.EqualFold(code, id) {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+var severities = []string{severityError, severityWarning, severityInfo}
+
+// ruleSettings — скомпилированные настройки одного правила.
+type ruleSettings struct {
+	enabled  bool
+	severity string
+}
+
+// compileRules проверяет идентификаторы и уровни и дополняет настройки
+// значениями по умолчанию: все правила включены с уровнем error.
+func compileRules(raw map[string]RuleConfig) (map[string]ruleSettings, error) {
+	rules := make(map[string]ruleSettings, len(ruleIDs))
+	for _, id := range ruleIDs {
+		rules[id] = ruleSettings{enabled: true, severity: defaultSeverity}
+	}
+
+	for id, cfg := range raw {
+		name, ok := resolveRule(id)
+		if !ok {
+			return nil, newError(errfUnknownRule, ErrUnknownRule, id, strings.Join(ruleIDs, ", "))
+		}
+		rule := rules[name]
+		if cfg.Enabled != nil {
+			rule.enabled = *cfg.Enabled
+		}
+		if cfg.Severity != "" {
+			severity := strings.ToLower(strings.TrimSpace(cfg.Severity))
+			if !slices.Contains(severities, severity) {
+				return nil, newError(errfInvalidSeverity, ErrInvalidSeverity, cfg.Severity, id, strings.Join(severities, ", "))
+			}
+			rule.severity = severity
+		}
+		rules[name] = rule
+	}
+
+	return rules, nil
+}
+
+// RuleSeverities возвращает уровни включенных правил по кодам (LML001 -> warning)
+// с учетом настройки rules, например для описаний правил в отчете SARIF.
+func RuleSeverities(cfg Config) (map[string]string, error) {
+	rules, err := compileRules(cfg.Rules)
+	if err != nil {
+		return nil, localizeError(err, cfg.Language)
+	}
+
+	severities := make(map[string]string, len(rules))
+	for id, rule := range rules {
+		if rule.enabled {
+			severities[ruleCodes[id]] = rule.severity
+		}
+	}
+	return severities, nil
+}
+
+// categorySeparator отделяет код правила от уровня в Category: "LML001/warning".
+const categorySeparator = "/"
+
+// ParseCategory разбирает Category диагностики logmsglint на код правила
+// и уровень. Для чужих диагностик ok равно false.
+func ParseCategory(category string) (code, severity string, ok bool) {
+	code, severity, _ = strings.Cut(category, categorySeparator)
+	name, ok := resolveRule(code)
+	if !ok || !slices.Contains(severities, severity) {
+		return "", "", false
+	}
+	return ruleCodes[name], severity, true
+}
+
+// ruleEnabled сообщает, включено ли правило.
+func (s *settings) ruleEnabled(rule string) bool {
+	return s.rules[rule].enabled
+}
+
+// report отправляет диагностику правила, если оно включено, не подавлено
+// директивой //logmsglint:ignore и не записано в baseline. Код правила
+// добавляется в начало сообщения, а в Category — код и настроенный уровень
+// ("LML001/warning"), чтобы внешние инструменты различали правила и их уровни
+// без разбора текста. Код в Category стабилен, его выделяет ParseCategory.
+// Результат сообщает, попала ли диагностика в отчет.
+func (s *settings) report(pass *analysis.Pass, rule string, diagnostic analysis.Diagnostic) bool {
+	rs, ok := s.rules[rule]
+	if !ok || !rs.enabled {
+		return false
+	}
+
+	code := ruleCodes[rule]
+	diagnostic.Message = code + ": " + diagnostic.Message
+	diagnostic.Category = code + categorySeparator + rs.severity
+	diagnostic.URL = ruleDocURL(code, s.language)
+
+	// Диагностики о самих директивах и baseline не подавляются ими же.
+	if rule != ruleDirective && rule != ruleBaseline {
+		if s.suppress(rule, diagnostic.Pos) || s.baselinePass.match(pass, rule, diagnostic) {
+			return false
+		}
+	}
+	pass.Report(diagnostic)
+	return true
+}
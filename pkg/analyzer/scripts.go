@@ -0,0 +1,121 @@
+package analyzer
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// defaultAllowedScripts — письменности, буквы которых разрешены по умолчанию.
+var defaultAllowedScripts = []string{"Latin"}
+
+// letterPolicy решает, какие буквы допустимы в сообщении: из разрешенных
+// письменностей (или только ASCII в строгом режиме) и отдельно разрешенные руны.
+type letterPolicy struct {
+	scripts   []*unicode.RangeTable
+	runes     map[rune]struct{}
+	asciiOnly bool
+}
+
+// compileLetterPolicy проверяет имена письменностей по таблицам unicode.Scripts.
+func compileLetterPolicy(scripts []string, allowedRunes []string, asciiOnly bool) (letterPolicy, error) {
+	if len(scripts) == 0 {
+		scripts = defaultAllowedScripts
+	}
+
+	policy := letterPolicy{asciiOnly: asciiOnly}
+	for _, name := range scripts {
+		table, ok := unicode.Scripts[strings.TrimSpace(name)]
+		if !ok {
+			return letterPolicy{}, newError(errfQuoted, ErrUnknownScript, name)
+		}
+		policy.scripts = append(policy.scripts, table)
+	}
+
+	for _, item := range allowedRunes {
+		for _, r := range item {
+			if policy.runes == nil {
+				policy.runes = make(map[rune]struct{})
+			}
+			policy.runes[r] = struct{}{}
+		}
+	}
+
+	return policy, nil
+}
+
+func (p letterPolicy) allows(r rune) bool {
+	if r < utf8.RuneSelf {
+		return true
+	}
+	// Комбинирующие знаки сами не буквы, но "e\u0301" выглядит как é,
+	// поэтому в режиме ASCII они запрещены наравне с буквами с диакритикой.
+	mark := p.asciiOnly && unicode.Is(unicode.Mn, r)
+	if !unicode.IsLetter(r) && !mark {
+		return true
+	}
+	if _, ok := p.runes[r]; ok {
+		return true
+	}
+	if p.asciiOnly {
+		return false
+	}
+	return unicode.In(r, p.scripts...)
+}
+
+// transliteration содержит только однозначные замены латинских букв в нижнем регистре.
+// Немецкие ä, ö, ü не включены: их пишут и как a/o/u, и как ae/oe/ue, а датские
+// и норвежские å и ø по той же причине пишут как aa и oe. Греческий и кириллица
+// тоже не включены: у них несколько стандартов транслитерации (для русского — ICAO,
+// ГОСТ и BGN), и автофикс выбрал бы один из них за автора.
+var transliteration = map[rune]string{
+	'à': "a", 'á': "a", 'â': "a", 'ã': "a", 'ā': "a", 'ă': "a", 'ą': "a",
+	'æ': "ae", 'ç': "c", 'ć': "c", 'ĉ': "c", 'ċ': "c", 'č': "c", 'ď': "d", 'đ': "d", 'ð': "d",
+	'è': "e", 'é': "e", 'ê': "e", 'ë': "e", 'ē': "e", 'ĕ': "e", 'ė': "e", 'ę': "e", 'ě': "e",
+	'ĝ': "g", 'ğ': "g", 'ġ': "g", 'ģ': "g", 'ĥ': "h", 'ħ': "h",
+	'ì': "i", 'í': "i", 'î': "i", 'ï': "i", 'ĩ': "i", 'ī': "i", 'ĭ': "i", 'į': "i", 'ı': "i",
+	'ĵ': "j", 'ķ': "k", 'ĺ': "l", 'ļ': "l", 'ľ': "l", 'ŀ': "l", 'ł': "l",
+	'ñ': "n", 'ń': "n", 'ņ': "n", 'ň': "n",
+	'ò': "o", 'ó': "o", 'ô': "o", 'õ': "o", 'ō': "o", 'ŏ': "o", 'ő': "o", 'œ': "oe",
+	'ŕ': "r", 'ŗ': "r", 'ř': "r", 'ś': "s", 'ŝ': "s", 'ş': "s", 'š': "s", 'ș': "s", 'ß': "ss",
+	'ţ': "t", 'ť': "t", 'ŧ': "t", 'ț': "t", 'þ': "th",
+	'ù': "u", 'ú': "u", 'û': "u", 'ũ': "u", 'ū': "u", 'ŭ': "u", 'ů': "u", 'ű': "u", 'ų': "u",
+	'ŵ': "w", 'ý': "y", 'ÿ': "y", 'ŷ': "y", 'ź': "z", 'ż': "z", 'ž': "z",
+}
+
+// transliterate заменяет недопустимые буквы по таблице. Если хотя бы для одной
+// буквы однозначной замены нет, автофикс не предлагается.
+func transliterate(text string, policy letterPolicy) (string, bool) {
+	runes := []rune(text)
+
+	var b strings.Builder
+	for i, r := range runes {
+		if policy.allows(r) {
+			b.WriteRune(r)
+			continue
+		}
+
+		replacement, ok := transliteration[unicode.ToLower(r)]
+		if !ok {
+			return "", false
+		}
+
+		// Заглавная буква дает заглавную замену: целиком, если соседние буквы
+		// тоже заглавные (ÞING -> THING), иначе только первую букву (Þing -> Thing).
+		if unicode.IsUpper(r) {
+			if neighbourIsUpper(runes, i) {
+				replacement = strings.ToUpper(replacement)
+			} else {
+				first, size := utf8.DecodeRuneInString(replacement)
+				replacement = string(unicode.ToUpper(first)) + replacement[size:]
+			}
+		}
+		b.WriteString(replacement)
+	}
+
+	return b.String(), true
+}
+
+func neighbourIsUpper(runes []rune, i int) bool {
+	return (i > 0 && unicode.IsUpper(runes[i-1])) || (i+1 < len(runes) && unicode.IsUpper(runes[i+1]))
+}
@@ -0,0 +1,202 @@
+package analyzer
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+//go:generate go run gen_emoji.go -o emoji_tables.go
+
+// defaultForbiddenPunctuation — знаки, запрещенные правилом no-specials по умолчанию.
+var defaultForbiddenPunctuation = []string{"!", "?", "...", "…"}
+
+// punctuationPolicy — скомпилированные настройки правила no-specials.
+type punctuationPolicy struct {
+	// forbidden отсортированы по убыванию длины, чтобы "..." удалялось раньше ".".
+	forbidden      []string
+	trailingPeriod bool
+	doubleSpaces   bool
+	tabs           bool
+	newlines       bool
+}
+
+// compilePunctuation проверяет запрещенные последовательности: пустая строка
+// совпала бы с любым сообщением, а буквы, цифры и пробелы автофикс вырезал бы из слов.
+func compilePunctuation(cfg PunctuationConfig) (punctuationPolicy, error) {
+	forbidden := cfg.Forbidden
+	if len(forbidden) == 0 {
+		forbidden = defaultForbiddenPunctuation
+	}
+
+	policy := punctuationPolicy{
+		trailingPeriod: cfg.TrailingPeriod,
+		doubleSpaces:   cfg.DoubleSpaces,
+		tabs:           cfg.Tabs,
+		newlines:       cfg.Newlines,
+	}
+	for _, item := range forbidden {
+		if item == "" || strings.IndexFunc(item, isWordOrSpace) >= 0 {
+			return punctuationPolicy{}, newError(errfQuoted, ErrInvalidPunctuation, item)
+		}
+		if !slices.Contains(policy.forbidden, item) {
+			policy.forbidden = append(policy.forbidden, item)
+		}
+	}
+	slices.SortStableFunc(policy.forbidden, func(a, b string) int { return cmp.Compare(len(b), len(a)) })
+
+	return policy, nil
+}
+
+func isWordOrSpace(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || unicode.IsSpace(r)
+}
+
+// containsSpecialSymbolsOrEmoji проверяет фрагмент сообщения. trailing означает,
+// что фрагмент завершает сообщение: только тогда проверяется точка в конце,
+// и только тогда допустим завершающий перевод строки.
+func containsSpecialSymbolsOrEmoji(text string, policy punctuationPolicy, trailing bool) bool {
+	for _, item := range policy.forbidden {
+		if strings.Contains(text, item) {
+			return true
+		}
+	}
+
+	switch {
+	case len(emojiSpans(text)) > 0:
+		return true
+	case policy.doubleSpaces && strings.Contains(text, "  "):
+		return true
+	case policy.tabs && strings.ContainsRune(text, '\t'):
+		return true
+	case policy.newlines && strings.ContainsAny(trimTrailingNewline(text, trailing), "\r\n"):
+		return true
+	case policy.trailingPeriod && trailing && strings.HasSuffix(strings.TrimRightFunc(text, unicode.IsSpace), "."):
+		return true
+	}
+	return false
+}
+
+// stripSpecialSymbolsAndEmoji удаляет эмодзи и запрещенные знаки и схлопывает
+// пробельные символы, в том числе табуляции и переводы строк, в один пробел.
+func stripSpecialSymbolsAndEmoji(text string, policy punctuationPolicy, trailing bool) string {
+	spans := emojiSpans(text)
+	for i := len(spans) - 1; i >= 0; i-- {
+		text = text[:spans[i][0]] + text[spans[i][1]:]
+	}
+
+	for _, item := range policy.forbidden {
+		text = strings.ReplaceAll(text, item, "")
+	}
+
+	text = strings.Join(strings.Fields(text), " ")
+	if policy.trailingPeriod && trailing {
+		text = strings.TrimRight(text, ".")
+	}
+	return text
+}
+
+func trimTrailingNewline(text string, trailing bool) string {
+	if !trailing {
+		return text
+	}
+	text = strings.TrimSuffix(text, "\n")
+	return strings.TrimSuffix(text, "\r")
+}
+
+// Служебные символы эмодзи-последовательностей из UTS #51.
+const (
+	zeroWidthJoiner   = '\u200D'
+	textPresentation  = '\uFE0E'
+	emojiPresentation = '\uFE0F'
+	combiningKeycap   = '\u20E3'
+	tagFirst          = '\U000E0020'
+	tagLast           = '\U000E007F'
+	regionalFirst     = '\U0001F1E6'
+	regionalLast      = '\U0001F1FF'
+)
+
+// emojiSpans возвращает границы эмодзи в тексте. Эмодзи выделяется целиком,
+// как кластер графем: символ с Emoji_Presentation или текстовый по умолчанию
+// символ с VS16 (©️) вместе с модификаторами цвета кожи, тегами и ZWJ-продолжениями
+// (👨‍💻, флаг Шотландии), пара региональных индикаторов (флаг 🇩🇪) или keycap (1️⃣).
+// Символ с VS15 (U+FE0E) явно показан как текст и эмодзи не считается.
+func emojiSpans(text string) [][2]int {
+	var spans [][2]int
+	for i := 0; i < len(text); {
+		r, size := utf8.DecodeRuneInString(text[i:])
+		if end, ok := emojiClusterEnd(text, i+size, r); ok {
+			spans = append(spans, [2]int{i, end})
+			i = end
+			continue
+		}
+		i += size
+	}
+	return spans
+}
+
+// emojiClusterEnd решает, начинается ли эмодзи с руны r, и возвращает конец
+// кластера. end — позиция сразу после r.
+func emojiClusterEnd(text string, end int, r rune) (int, bool) {
+	switch {
+	case isRegionalIndicator(r):
+		if next, size := utf8.DecodeRuneInString(text[end:]); isRegionalIndicator(next) {
+			end += size
+		}
+		return end, true
+	case r == '#' || r == '*' || (r >= '0' && r <= '9'):
+		next, size := utf8.DecodeRuneInString(text[end:])
+		if next == emojiPresentation {
+			end += size
+			next, size = utf8.DecodeRuneInString(text[end:])
+		}
+		return end + size, next == combiningKeycap
+	case unicode.Is(emojiPresentationDefault, r) || unicode.Is(emojiModifier, r):
+		return pictographicClusterEnd(text, end)
+	case unicode.Is(emojiCharacter, r):
+		// ©, ™, ↔ и подобные по умолчанию текст: эмодзи они становятся только
+		// с VS16 или модификатором цвета кожи.
+		next, _ := utf8.DecodeRuneInString(text[end:])
+		if next != emojiPresentation && !unicode.Is(emojiModifier, next) {
+			return 0, false
+		}
+		return pictographicClusterEnd(text, end)
+	case r != zeroWidthJoiner && unicode.Is(emojiComponent, r):
+		// Одиночные VS16, keycap, модификаторы и теги — остатки эмодзи.
+		return end, true
+	}
+	return 0, false
+}
+
+// pictographicClusterEnd продолжает кластер после пиктограммы: модификаторы,
+// селекторы вариантов, теги и следующие пиктограммы через ZWJ.
+func pictographicClusterEnd(text string, end int) (int, bool) {
+	asText := false
+	for {
+		next, size := utf8.DecodeRuneInString(text[end:])
+		switch {
+		case size == 0:
+			return end, !asText
+		case next == textPresentation:
+			asText = true
+		case next == emojiPresentation || unicode.Is(emojiModifier, next) || (next >= tagFirst && next <= tagLast):
+			asText = false
+		case next == zeroWidthJoiner:
+			joined, joinedSize := utf8.DecodeRuneInString(text[end+size:])
+			if !unicode.Is(emojiPictographic, joined) {
+				return end, !asText
+			}
+			size += joinedSize
+			asText = false
+		default:
+			return end, !asText
+		}
+		end += size
+	}
+}
+
+func isRegionalIndicator(r rune) bool {
+	return r >= regionalFirst && r <= regionalLast
+}
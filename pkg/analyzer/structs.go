@@ -0,0 +1,287 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/constant"
+	"go/types"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// logMethod — метод, через который значение само управляет своим выводом:
+// имя, число параметров и число результатов.
+type logMethod struct {
+	name            string
+	params, results int
+}
+
+var (
+	logValuerMethod       = &logMethod{name: "LogValue", params: 0, results: 1}         // slog.LogValuer
+	objectMarshalerMethod = &logMethod{name: "MarshalLogObject", params: 1, results: 1} // zapcore.ObjectMarshaler
+	formatterMethod       = &logMethod{name: "Format", params: 2, results: 0}           // fmt.Formatter
+	errorMethod           = &logMethod{name: "Error", params: 0, results: 1}            // error
+	stringerMethod        = &logMethod{name: "String", params: 0, results: 1}           // fmt.Stringer
+	goStringerMethod      = &logMethod{name: "GoString", params: 0, results: 1}         // fmt.GoStringer
+)
+
+// Методы, которыми fmt заменяет вывод полей: под %v и %+v — Format, Error
+// и String, под %#v — Format и GoString.
+var (
+	valueVerbMethods      = []*logMethod{formatterMethod, errorMethod, stringerMethod}
+	sharpValueVerbMethods = []*logMethod{formatterMethod, goStringerMethod}
+)
+
+// structDumpConstructors перечисляет конструкторы атрибутов, которые выводят
+// значение целиком, и метод, который конструктор вызывает вместо рефлексии:
+// slog.Any учитывает только LogValuer, zap.Any и zap.Object — ObjectMarshaler,
+// а zap.Reflect всегда сериализует поля. Значение — второй аргумент.
+var structDumpConstructors = map[sinkKey][]*logMethod{
+	{pkgPath: "log/slog", name: "Any"}:            {logValuerMethod},
+	{pkgPath: "go.uber.org/zap", name: "Any"}:     {objectMarshalerMethod},
+	{pkgPath: "go.uber.org/zap", name: "Reflect"}: nil,
+	{pkgPath: "go.uber.org/zap", name: "Object"}:  {objectMarshalerMethod},
+}
+
+// dumpedValue — аргумент, который попадет в лог целиком, и методы, которыми
+// его тип может заменить вывод полей (nil — вывод всегда через рефлексию).
+type dumpedValue struct {
+	expr    ast.Expr
+	methods []*logMethod
+	// viaFmt означает, что значение форматирует fmt: он вызывает методы
+	// вывода и у вложенных значений, а вложенные указатели печатает адресами.
+	viaFmt bool
+}
+
+// checkSecretStructs ищет структуры с секретными полями, которые попадают
+// в лог целиком: slog.Any("cfg", cfg), zap.Any("user", u) или аргумент
+// под глаголом %v/%+v в форматирующем вызове логгера.
+func checkSecretStructs(pass *analysis.Pass, cfg *settings, values funcValues, call *ast.CallExpr) {
+	for _, dumped := range dumpedValues(pass, cfg, values, call) {
+		tv, ok := pass.TypesInfo.Types[stripParens(dumped.expr)]
+		if !ok || tv.Type == nil || !tv.IsValue() {
+			continue
+		}
+
+		// Метод ищем в наборе методов самого аргумента: LogValue у *T
+		// не вызывается, если в slog.Any передано значение T.
+		if implementsLogMethod(tv.Type, dumped.methods) {
+			continue
+		}
+
+		walker := fieldWalker{cfg: cfg, seen: make(map[types.Type]struct{})}
+		if dumped.viaFmt {
+			walker.fmtMethods = dumped.methods
+		}
+		path, ok := walker.secretFieldPath(tv.Type, true, true)
+		if !ok {
+			continue
+		}
+
+		cfg.report(pass, ruleSecretStruct, analysis.Diagnostic{
+			Pos:     dumped.expr.Pos(),
+			End:     dumped.expr.End(),
+			Message: cfg.msgs.text(diagSensitiveStruct) + ": " + path,
+		})
+	}
+}
+
+// dumpedValues возвращает аргументы вызова, которые будут выведены целиком.
+// Под %v значение форматирует fmt: LogValue и MarshalLogObject не вызываются,
+// зато вызываются String, Error и Format.
+func dumpedValues(pass *analysis.Pass, cfg *settings, values funcValues, call *ast.CallExpr) []dumpedValue {
+	target, ok := calledFunction(pass, values, call)
+	if !ok || target.fn.Pkg() == nil || target.argOffset > len(call.Args) {
+		return nil
+	}
+
+	fn := target.fn
+	args := call.Args[target.argOffset:]
+
+	key := sinkKey{pkgPath: fn.Pkg().Path(), recv: receiverName(fn), name: fn.Name()}
+	if methods, ok := structDumpConstructors[key]; ok {
+		if len(args) < 2 {
+			return nil
+		}
+		return []dumpedValue{{expr: args[1], methods: methods}}
+	}
+
+	msgIndex, ok := sinkMessageIndex(pass, cfg, fn)
+	if !ok || msgIndex == firstStringLiteralArg || !strings.HasSuffix(fn.Name(), "f") || msgIndex >= len(args) {
+		return nil
+	}
+
+	tv, ok := pass.TypesInfo.Types[stripParens(args[msgIndex])]
+	if !ok || tv.Value == nil || tv.Value.Kind() != constant.String {
+		return nil
+	}
+
+	formatArgs := args[msgIndex+1:]
+	var dumped []dumpedValue
+	for _, verb := range valueVerbArgs(constant.StringVal(tv.Value)) {
+		if verb.arg >= len(formatArgs) {
+			continue
+		}
+		methods := valueVerbMethods
+		if verb.sharp {
+			methods = sharpValueVerbMethods
+		}
+		dumped = append(dumped, dumpedValue{expr: formatArgs[verb.arg], methods: methods, viaFmt: true})
+	}
+	return dumped
+}
+
+// valueVerb — аргумент под глаголом %v; sharp отмечает %#v.
+type valueVerb struct {
+	arg   int
+	sharp bool
+}
+
+// valueVerbArgs возвращает аргументы, которые форматируются глаголом %v
+// (в том числе %+v и %#v). Учитываются флаги, ширина и точность со звездочкой
+// и явные индексы вида %[2]v.
+func valueVerbArgs(format string) []valueVerb {
+	var result []valueVerb
+	argNum := 0
+	for i := 0; i < len(format); i++ {
+		if format[i] != '%' {
+			continue
+		}
+		i++
+
+		sharp := false
+		for i < len(format) && strings.IndexByte("+-# 0", format[i]) >= 0 {
+			sharp = sharp || format[i] == '#'
+			i++
+		}
+
+		readIndex := func() {
+			if i >= len(format) || format[i] != '[' {
+				return
+			}
+			end := strings.IndexByte(format[i:], ']')
+			if end < 0 {
+				return
+			}
+			var n int
+			if _, err := fmt.Sscanf(format[i+1:i+end], "%d", &n); err == nil && n > 0 {
+				argNum = n - 1
+			}
+			i += end + 1
+		}
+		readNumber := func() {
+			readIndex()
+			if i < len(format) && format[i] == '*' {
+				argNum++
+				i++
+				return
+			}
+			for i < len(format) && format[i] >= '0' && format[i] <= '9' {
+				i++
+			}
+		}
+
+		readNumber()
+		if i < len(format) && format[i] == '.' {
+			i++
+			readNumber()
+		}
+		readIndex()
+
+		if i >= len(format) {
+			break
+		}
+		switch format[i] {
+		case '%':
+			continue
+		case 'v':
+			result = append(result, valueVerb{arg: argNum, sharp: sharp})
+		}
+		argNum++
+	}
+	return result
+}
+
+// fieldWalker ищет секретные поля так, как значение выведет сериализатор.
+type fieldWalker struct {
+	cfg  *settings
+	seen map[types.Type]struct{}
+	// fmtMethods — методы, которыми fmt заменяет вывод вложенных значений.
+	// nil означает вывод рефлексией (slog.Any, zap.Reflect): она раскрывает
+	// указатели на любой глубине и методы вложенных значений не вызывает.
+	fmtMethods []*logMethod
+}
+
+// secretFieldPath рекурсивно обходит поля структуры и возвращает путь
+// до первого секретного поля, например "Credentials.Password". top отмечает
+// сам аргумент, visible — что fmt может вызвать методы значения: до
+// неэкспортированных полей через рефлексию их не вызвать.
+func (w fieldWalker) secretFieldPath(typ types.Type, top, visible bool) (string, bool) {
+	// Методы проверяются до отметки о посещении: тот же тип в неэкспортированном
+	// поле fmt выведет рефлексией, и его поля нужно обойти.
+	viaFmt := w.fmtMethods != nil
+	if viaFmt && !top && visible && implementsLogMethod(typ, w.fmtMethods) {
+		return "", false
+	}
+
+	if _, done := w.seen[typ]; done {
+		return "", false
+	}
+	w.seen[typ] = struct{}{}
+
+	switch t := typ.Underlying().(type) {
+	case *types.Pointer:
+		// fmt раскрывает только указатель верхнего уровня (&{...}),
+		// вложенные указатели печатаются адресами.
+		if viaFmt && !top {
+			return "", false
+		}
+		return w.secretFieldPath(t.Elem(), false, visible)
+	case *types.Slice:
+		return w.secretFieldPath(t.Elem(), false, visible)
+	case *types.Array:
+		return w.secretFieldPath(t.Elem(), false, visible)
+	case *types.Map:
+		return w.secretFieldPath(t.Elem(), false, visible)
+	case *types.Struct:
+		for i := 0; i < t.NumFields(); i++ {
+			field := t.Field(i)
+			if w.cfg.secretTag.matches(t.Tag(i)) || isSensitiveName(field.Name(), w.cfg.patterns) {
+				// Пустые структуры и функции ничего не выводят, а числа и булевы
+				// поля с "секретными" именами (TokenTTL) секретов не содержат.
+				if isScalarNonString(field.Type()) {
+					continue
+				}
+				return field.Name(), true
+			}
+			if path, ok := w.secretFieldPath(field.Type(), false, visible && field.Exported()); ok {
+				return field.Name() + "." + path, true
+			}
+		}
+	}
+
+	return "", false
+}
+
+// implementsLogMethod проверяет, что в наборе методов типа есть один из
+// методов вывода.
+func implementsLogMethod(typ types.Type, methods []*logMethod) bool {
+	mset := types.NewMethodSet(typ)
+	for _, method := range methods {
+		sel := mset.Lookup(nil, method.name)
+		if sel == nil {
+			continue
+		}
+		sig := sel.Type().(*types.Signature)
+		if sig.Params().Len() == method.params && sig.Results().Len() == method.results {
+			return true
+		}
+	}
+	return false
+}
+
+func isScalarNonString(typ types.Type) bool {
+	basic, ok := typ.Underlying().(*types.Basic)
+	return ok && basic.Info()&types.IsString == 0
+}
@@ -0,0 +1,398 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/constant"
+	"go/token"
+	"go/types"
+	"reflect"
+	"strings"
+
+	"golang.org/x/tools/go/analysis"
+	"golang.org/x/tools/go/analysis/passes/buildssa"
+	"golang.org/x/tools/go/ssa"
+)
+
+// taintKeyedSources перечисляет функции, результат которых считается секретом,
+// если ключ (аргумент с указанным индексом) похож на чувствительное имя:
+// os.Getenv("DB_PASSWORD"), r.Header.Get("Authorization").
+var taintKeyedSources = map[sinkKey]int{
+	{pkgPath: "os", name: "Getenv"}:                       0,
+	{pkgPath: "os", name: "LookupEnv"}:                    0,
+	{pkgPath: "syscall", name: "Getenv"}:                  0,
+	{pkgPath: "net/http", recv: "Header", name: "Get"}:    0,
+	{pkgPath: "net/http", recv: "Header", name: "Values"}: 0,
+}
+
+// taintPropagatingPackages — пакеты, функции которых возвращают значение,
+// производное от аргументов: fmt.Sprintf, strings.TrimSpace, strconv.Quote.
+var taintPropagatingPackages = map[string]struct{}{
+	"fmt":     {},
+	"strings": {},
+	"strconv": {},
+	"bytes":   {},
+	"errors":  {},
+}
+
+// structTag описывает тег поля вида log:"-": ключ и ожидаемое значение.
+type structTag struct {
+	key   string
+	value string
+}
+
+// taintStep — звено пути распространения секрета: откуда пришло значение
+// и где это произошло. У источника prev == nil.
+type taintStep struct {
+	prev ssa.Value
+	pos  token.Pos
+	desc string
+}
+
+// taintAnalysis хранит состояние taint-анализа одной SSA-функции.
+type taintAnalysis struct {
+	pass     *analysis.Pass
+	cfg      *settings
+	steps    map[ssa.Value]*taintStep
+	queue    []ssa.Value
+	reported map[*ssa.Call]struct{}
+}
+
+// runTaint отслеживает, как значения из источников секретов (переменные
+// окружения, заголовок Authorization, секретные поля структур) доходят
+// до вызовов логгеров. Анализ внутрипроцедурный и построен на SSA.
+func runTaint(pass *analysis.Pass, cfg *settings) {
+	ssaInfo, ok := pass.ResultOf[buildssa.Analyzer].(*buildssa.SSA)
+	if !ok {
+		return
+	}
+
+	for _, fn := range ssaInfo.SrcFuncs {
+		t := &taintAnalysis{
+			pass:     pass,
+			cfg:      cfg,
+			steps:    make(map[ssa.Value]*taintStep),
+			reported: make(map[*ssa.Call]struct{}),
+		}
+		t.run(fn)
+	}
+}
+
+func (t *taintAnalysis) run(fn *ssa.Function) {
+	for _, block := range fn.Blocks {
+		for _, instr := range block.Instrs {
+			v, ok := instr.(ssa.Value)
+			if !ok {
+				continue
+			}
+			if desc, ok := t.sourceOf(v); ok {
+				t.mark(v, &taintStep{pos: v.Pos(), desc: desc})
+			}
+		}
+	}
+
+	for len(t.queue) > 0 {
+		v := t.queue[0]
+		t.queue = t.queue[1:]
+
+		refs := v.Referrers()
+		if refs == nil {
+			continue
+		}
+		for _, instr := range *refs {
+			t.propagate(v, instr)
+		}
+	}
+}
+
+func (t *taintAnalysis) mark(v ssa.Value, step *taintStep) {
+	if _, seen := t.steps[v]; seen {
+		return
+	}
+	t.steps[v] = step
+	t.queue = append(t.queue, v)
+}
+
+// sourceOf определяет, порождает ли инструкция секрет.
+func (t *taintAnalysis) sourceOf(v ssa.Value) (string, bool) {
+	switch instr := v.(type) {
+	case *ssa.Call:
+		fn := calleeFunc(instr.Common())
+		if fn == nil || fn.Pkg() == nil {
+			return "", false
+		}
+
+		key := sinkKey{pkgPath: fn.Pkg().Path(), recv: receiverName(fn), name: fn.Name()}
+		if _, ok := t.cfg.taintSources[key]; ok {
+			return t.cfg.msgs.sprintf(msgTaintSourceCall, fn.FullName()), true
+		}
+
+		idx, ok := taintKeyedSources[key]
+		if !ok {
+			return "", false
+		}
+
+		args := instr.Call.Args
+		if instr.Call.Signature().Recv() != nil && !instr.Call.IsInvoke() {
+			// У статического вызова метода получатель идет первым аргументом.
+			idx++
+		}
+		if idx >= len(args) {
+			return "", false
+		}
+		name, ok := constString(args[idx])
+		if !ok || !isSensitiveName(name, t.cfg.patterns) {
+			return "", false
+		}
+		return t.cfg.msgs.sprintf(msgTaintSourceKeyed, fn.FullName(), name), true
+	case *ssa.FieldAddr:
+		return t.secretField(instr.X.Type(), instr.Field)
+	case *ssa.Field:
+		return t.secretField(instr.X.Type(), instr.Field)
+	}
+
+	return "", false
+}
+
+// secretField проверяет, что поле структуры названо как секрет или помечено тегом.
+// Источником считаются только строки и []byte: числа вроде TokenLength или
+// PasswordMinLen секретов не содержат.
+func (t *taintAnalysis) secretField(typ types.Type, index int) (string, bool) {
+	if ptr, ok := typ.Underlying().(*types.Pointer); ok {
+		typ = ptr.Elem()
+	}
+	st, ok := typ.Underlying().(*types.Struct)
+	if !ok || index >= st.NumFields() {
+		return "", false
+	}
+
+	field := st.Field(index)
+	if !isStringOrBytes(field.Type()) {
+		return "", false
+	}
+	if t.cfg.secretTag.matches(st.Tag(index)) {
+		return t.cfg.msgs.sprintf(msgTaintTaggedField, field.Name(), t.cfg.secretTag), true
+	}
+	if isSensitiveName(field.Name(), t.cfg.patterns) {
+		return t.cfg.msgs.sprintf(msgTaintField, field.Name()), true
+	}
+	return "", false
+}
+
+// propagate переносит метку с v на инструкцию instr, которая использует v.
+func (t *taintAnalysis) propagate(v ssa.Value, instr ssa.Instruction) {
+	step := func(to ssa.Value) *taintStep {
+		return &taintStep{prev: v, pos: to.Pos(), desc: t.cfg.msgs.text(msgTaintFlows)}
+	}
+
+	switch instr := instr.(type) {
+	case *ssa.BinOp, *ssa.Convert, *ssa.ChangeType, *ssa.ChangeInterface, *ssa.MakeInterface,
+		*ssa.Phi, *ssa.Extract, *ssa.Slice, *ssa.TypeAssert, *ssa.Field, *ssa.FieldAddr,
+		*ssa.Index, *ssa.IndexAddr, *ssa.Lookup, *ssa.SliceToArrayPointer:
+		to := instr.(ssa.Value)
+		t.mark(to, step(to))
+	case *ssa.UnOp:
+		// Загрузка по адресу, в который записан секрет, и прочие унарные операции.
+		t.mark(instr, step(instr))
+	case *ssa.Store:
+		// Запись секрета в ячейку (например, в массив вариадических аргументов)
+		// помечает всю ячейку и базовый объект, из которого она получена.
+		if instr.Val != v {
+			return
+		}
+		for addr := instr.Addr; addr != nil; addr = baseAddr(addr) {
+			t.mark(addr, &taintStep{prev: v, pos: instr.Pos(), desc: t.cfg.msgs.text(msgTaintStored)})
+		}
+	case *ssa.Call:
+		t.propagateCall(v, instr)
+	}
+}
+
+// propagateCall либо репортит попадание секрета в логгер, либо переносит метку
+// на результат вызова, если функция лишь преобразует аргументы.
+func (t *taintAnalysis) propagateCall(v ssa.Value, call *ssa.Call) {
+	common := call.Common()
+	if common.Value == v && !common.IsInvoke() {
+		return
+	}
+
+	if builtin, ok := common.Value.(*ssa.Builtin); ok {
+		if builtin.Name() == "append" {
+			t.mark(call, &taintStep{prev: v, pos: call.Pos(), desc: t.cfg.msgs.text(msgTaintAppended)})
+		}
+		return
+	}
+
+	fn := calleeFunc(common)
+	if fn == nil || fn.Pkg() == nil {
+		return
+	}
+
+	if _, ok := sinkMessageIndex(t.pass, t.cfg, fn); ok {
+		t.report(v, call, fn)
+		return
+	}
+
+	if _, ok := taintPropagatingPackages[fn.Pkg().Path()]; ok || isAttributeConstructor(fn) || isLoggerBuilder(fn) {
+		t.mark(call, &taintStep{prev: v, pos: call.Pos(), desc: t.cfg.msgs.sprintf(msgTaintPassesThrough, fn.FullName())})
+	}
+}
+
+func (t *taintAnalysis) report(v ssa.Value, call *ssa.Call, fn *types.Func) {
+	if _, done := t.reported[call]; done {
+		return
+	}
+	t.reported[call] = struct{}{}
+
+	var related []analysis.RelatedInformation
+	for cur := v; cur != nil; {
+		step := t.steps[cur]
+		if step == nil {
+			break
+		}
+		if step.pos.IsValid() {
+			related = append(related, analysis.RelatedInformation{Pos: step.pos, Message: step.desc})
+		}
+		cur = step.prev
+	}
+
+	// Путь собирали от логгера к источнику, а показывать его удобнее от источника.
+	for i, j := 0, len(related)-1; i < j; i, j = i+1, j-1 {
+		related[i], related[j] = related[j], related[i]
+	}
+
+	t.cfg.report(t.pass, ruleTaint, analysis.Diagnostic{
+		Pos:     call.Pos(),
+		Message: t.cfg.msgs.text(diagTainted) + ": " + fn.FullName(),
+		Related: related,
+	})
+}
+
+// calleeFunc возвращает объявленную функцию или метод, который вызывается
+// статически или через интерфейс.
+func calleeFunc(common *ssa.CallCommon) *types.Func {
+	if common.IsInvoke() {
+		return common.Method
+	}
+
+	callee := common.StaticCallee()
+	if callee == nil {
+		return nil
+	}
+
+	fn, _ := callee.Object().(*types.Func)
+	return fn
+}
+
+// baseAddr возвращает адрес объекта, внутри которого лежит ячейка addr.
+func baseAddr(addr ssa.Value) ssa.Value {
+	switch a := addr.(type) {
+	case *ssa.IndexAddr:
+		return a.X
+	case *ssa.FieldAddr:
+		return a.X
+	default:
+		return nil
+	}
+}
+
+func constString(v ssa.Value) (string, bool) {
+	c, ok := v.(*ssa.Const)
+	if !ok || c.Value == nil || c.Value.Kind() != constant.String {
+		return "", false
+	}
+	return constant.StringVal(c.Value), true
+}
+
+// isSensitiveName применяет паттерны чувствительных данных к имени
+// переменной окружения, заголовка или поля.
+func isSensitiveName(name string, patterns []sensitivePattern) bool {
+	for _, phrase := range identifierPhrases(name) {
+		if containsSensitiveData(phrase, patterns) {
+			return true
+		}
+	}
+	return false
+}
+
+// isAttributeConstructor распознает конструкторы slog.Attr и zap.Field:
+// атрибут, собранный из секрета, тоже несет секрет.
+func isAttributeConstructor(fn *types.Func) bool {
+	if receiverName(fn) != "" {
+		return false
+	}
+	switch fn.Pkg().Path() {
+	case "log/slog":
+		_, ok := slogAttrConstructors[fn.Name()]
+		return ok
+	case "go.uber.org/zap":
+		return isZapFieldConstructor(fn)
+	}
+	return false
+}
+
+// loggerPackages — пакеты логгеров, у которых есть методы-построители
+// вида logger.With("k", v) или event.Str("k", v).
+var loggerPackages = map[string]struct{}{
+	"log/slog":                   {},
+	"go.uber.org/zap":            {},
+	"github.com/rs/zerolog":      {},
+	"github.com/sirupsen/logrus": {},
+}
+
+// isLoggerBuilder распознает методы логгеров, которые возвращают новый логгер
+// или событие с прикрепленными атрибутами: секрет в атрибутах переходит
+// в получившийся логгер и дальше в его вызовы.
+func isLoggerBuilder(fn *types.Func) bool {
+	if _, ok := loggerPackages[fn.Pkg().Path()]; !ok || receiverName(fn) == "" {
+		return false
+	}
+
+	sig := fn.Type().(*types.Signature)
+	if sig.Results().Len() != 1 {
+		return false
+	}
+
+	result := sig.Results().At(0).Type()
+	if ptr, ok := result.(*types.Pointer); ok {
+		result = ptr.Elem()
+	}
+	named, ok := result.(*types.Named)
+	return ok && named.Obj().Pkg() == fn.Pkg()
+}
+
+func parseStructTag(raw string) (structTag, error) {
+	key, quoted, ok := strings.Cut(strings.TrimSpace(raw), ":")
+	if !ok || key == "" || strings.ContainsAny(key, " \t\"") {
+		return structTag{}, newError(errfQuoted, ErrInvalidSecretTag, raw)
+	}
+
+	value, ok := reflect.StructTag(raw).Lookup(key)
+	if !ok || quoted != fmt.Sprintf("%q", value) {
+		return structTag{}, newError(errfQuoted, ErrInvalidSecretTag, raw)
+	}
+
+	return structTag{key: key, value: value}, nil
+}
+
+func (t structTag) matches(tag string) bool {
+	if t.key == "" {
+		return false
+	}
+	value, ok := reflect.StructTag(tag).Lookup(t.key)
+	return ok && value == t.value
+}
+
+func (t structTag) String() string {
+	return fmt.Sprintf("%s:%q", t.key, t.value)
+}
+
+func isStringOrBytes(typ types.Type) bool {
+	switch t := typ.Underlying().(type) {
+	case *types.Basic:
+		return t.Info()&types.IsString != 0
+	case *types.Slice:
+		elem, ok := t.Elem().Underlying().(*types.Basic)
+		return ok && elem.Kind() == types.Byte
+	}
+	return false
+}
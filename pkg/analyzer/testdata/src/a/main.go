@@ -7,26 +7,45 @@ import (
 	"go.uber.org/zap"
 )
 
+type config struct {
+	APIKey string
+	Port   int
+}
+
+type request struct {
+	Token       string
+	TokenLength int
+}
+
 func demo() {
 	logger := zap.NewNop()
 	sugar := logger.Sugar()
 	password := "12345"
 	apiKey := "secret"
 	token := "abc"
+	cfg := config{APIKey: apiKey, Port: 8080}
+	req := request{Token: token}
+
+	slog.Info("User logged in")                                 // want "LML001: log message must start with a lowercase English letter"
+	slog.Warn("privet мир")                                     // want "LML002: log message must contain only English text \\(Cyrillic and other scripts are not allowed\\)"
+	slog.Error("request failed!")                               // want "LML003: log message must not contain special characters \\(!, \\?, \\.\\.\\.\\) or emoji"
+	slog.Debug("token=123")                                     // want "LML004: log message contains potentially sensitive data"
+	slog.InfoContext(context.Background(), "session id leaked") // want "LML004: log message contains potentially sensitive data"
+	slog.Info("user password: " + password)                     // want "LML004: log message contains potentially sensitive data"
+	slog.Debug("api_key=" + apiKey)                             // want "LML004: log message contains potentially sensitive data"
+
+	logger.Info("api_key leaked")         // want "LML004: log message contains potentially sensitive data"
+	logger.Info("token: " + token)        // want "LML004: log message contains potentially sensitive data"
+	logger.Warn("request...failed")       // want "LML003: log message must not contain special characters \\(!, \\?, \\.\\.\\.\\) or emoji"
+	sugar.Infof("password: %s", "qwerty") // want "LML004: log message contains potentially sensitive data"
+	sugar.Infow("hello 😊", "k", "v")      // want "LML003: log message must not contain special characters \\(!, \\?, \\.\\.\\.\\) or emoji"
 
-	slog.Info("User logged in")                                 // want "лог-сообщение должно начинаться со строчной английской буквы"
-	slog.Warn("privet мир")                                     // want "лог-сообщение должно содержать только английский текст \\(кириллица и другие алфавиты запрещены\\)"
-	slog.Error("request failed!")                               // want "лог-сообщение не должно содержать спецсимволы \\(!, \\?, \\.\\.\\.\\) и эмодзи"
-	slog.Debug("token=123")                                     // want "лог-сообщение содержит потенциально чувствительные данные"
-	slog.InfoContext(context.Background(), "session id leaked") // want "лог-сообщение содержит потенциально чувствительные данные"
-	slog.Info("user password: " + password)                     // want "лог-сообщение содержит потенциально чувствительные данные"
-	slog.Debug("api_key=" + apiKey)                             // want "лог-сообщение содержит потенциально чувствительные данные"
-
-	logger.Info("api_key leaked")         // want "лог-сообщение содержит потенциально чувствительные данные"
-	logger.Info("token: " + token)        // want "лог-сообщение содержит потенциально чувствительные данные"
-	logger.Warn("request...failed")       // want "лог-сообщение не должно содержать спецсимволы \\(!, \\?, \\.\\.\\.\\) и эмодзи"
-	sugar.Infof("password: %s", "qwerty") // want "лог-сообщение содержит потенциально чувствительные данные"
-	sugar.Infow("hello 😊", "k", "v")      // want "лог-сообщение не должно содержать спецсимволы \\(!, \\?, \\.\\.\\.\\) и эмодзи"
+	sugar.Infof("user %s", password)                         // want "LML006: log argument contains potentially sensitive data"
+	slog.Info("login", "u", "bob", "p", apiKey)              // want "LML006: log argument contains potentially sensitive data"
+	logger.Info("auth", zap.String("t", token))              // want "LML006: log argument contains potentially sensitive data"
+	slog.Info("loaded", "key", cfg.APIKey, "port", cfg.Port) // want "LML006: log argument contains potentially sensitive data"
+	slog.Info("request", slog.String("auth", req.Token))     // want "LML006: log argument contains potentially sensitive data"
+	slog.Info("request", "len", req.TokenLength, "port", cfg.Port)
 
 	slog.Info("valid message")
 	logger.Info("valid message")
@@ -0,0 +1,14 @@
+package asciionly
+
+import "log/slog"
+
+func demo() {
+	slog.Info("café opened")       // want "LML002: log message must contain only English text"
+	slog.Info("straße closed")     // want "LML002: log message must contain only English text"
+	slog.Info("zażółć gęślą jaźń") // want "LML002: log message must contain only English text"
+	slog.Info("größe exceeded")    // want "LML002: log message must contain only English text"
+	slog.Info("cafe\u0301 closed") // want "LML002: log message must contain only English text"
+	slog.Info("københavn ready")   // want "LML002: log message must contain only English text"
+	slog.Info("latency 5µs")
+	slog.Info("plain ascii message")
+}
@@ -0,0 +1,41 @@
+package attrkeys
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+const keyToken = "token"
+
+func demo(ctx context.Context, logger *slog.Logger, pw, t, k string) {
+	zl := zap.NewNop()
+	sugar := zl.Sugar()
+
+	// Конструкторы slog.Attr проверяются где угодно, не только внутри вызова лога.
+	attrs := []slog.Attr{slog.String("password", pw)} // want "LML005: log attribute key contains potentially sensitive data"
+	slog.LogAttrs(ctx, slog.LevelInfo, "user loaded", attrs...)
+	slog.Info("user loaded", slog.Any("secret", k)) // want "LML005: log attribute key contains potentially sensitive data"
+
+	// Пары ключ/значение в slog: ключом считается строка на нечетной позиции.
+	slog.Info("login", "user", "bob", "api_key", k)                        // want "LML005: log attribute key contains potentially sensitive data"
+	logger.InfoContext(ctx, "login", slog.Int("id", 1), keyToken, t)       // want "LML005: log attribute key contains potentially sensitive data"
+	logger.With("authorization", t).Warn("request rejected")               // want "LML005: log attribute key contains potentially sensitive data"
+	logger.Log(ctx, slog.LevelWarn, "retry", "attempt", 1, "password", pw) // want "LML005: log attribute key contains potentially sensitive data"
+
+	// Поля zap.
+	zl.Info("user loaded", zap.String("token", t))          // want "LML005: log attribute key contains potentially sensitive data"
+	zl.With(zap.Any("access_key", k)).Warn("rotated")       // want "LML005: log attribute key contains potentially sensitive data"
+	sugar.Infow("ok", "api_key", k)                         // want "LML005: log attribute key contains potentially sensitive data"
+	sugar.Errorw("failed", zap.Int("code", 1), "secret", k) // want "LML005: log attribute key contains potentially sensitive data"
+	sugar.With("password", pw).Infow("ok")                  // want "LML005: log attribute key contains potentially sensitive data"
+
+	// Значения и безопасные ключи не триггерят правило.
+	slog.Info("login", "user", "password")
+	slog.Info("login", slog.String("user", pw), "attempt", 2)
+	zl.Info("user loaded", zap.String("user", t), zap.Error(errors.New("boom")))
+	sugar.Infow("ok", "user", "token")
+	sugar.Infof("format %s", "password")
+}
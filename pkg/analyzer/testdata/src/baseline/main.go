@@ -0,0 +1,16 @@
+package baseline // want "LML012: baseline has 1 finding\\(s\\) of rule no-specials \\(hash [0-9a-f]{12}\\) that no longer occur"
+
+import "log/slog"
+
+// Строки сдвинулись относительно baseline, а отступы изменились:
+// ключи записей от этого не зависят.
+func demo() {
+	if true {
+		slog.Info("Started!")
+	}
+	slog.Info("user password reset")
+	slog.Info("cache miss?")
+	slog.Info("cache miss?")
+	slog.Info("cache miss?") // want "LML003: log message must not contain special characters"
+	slog.Info("New message") // want "LML001: log message must start with a lowercase English letter"
+}
@@ -0,0 +1,11 @@
+package baselinelang
+
+import "log/slog"
+
+// Baseline записан с language: en, а проверка идет с language: ru:
+// записи от языка сообщений не зависят.
+func demo() {
+	slog.Info("Started!")
+	slog.Info("user password reset")
+	slog.Info("Ready?") // want "LML001: лог-сообщение должно начинаться со строчной английской буквы" "LML003: лог-сообщение не должно содержать спецсимволы"
+}
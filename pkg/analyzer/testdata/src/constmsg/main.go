@@ -0,0 +1,39 @@
+package constmsg
+
+import (
+	"log/slog"
+
+	"constmsg/messages"
+)
+
+// msgStart репортится в месте вызова, а автофикс переписывает объявление.
+const msgStart = "Starting server"
+
+const (
+	msgDone   = "done!"
+	msgPrefix = "api_key"
+	msgJoined = msgPrefix + " loaded"
+	msgValid  = "server started"
+)
+
+type greeting string
+
+const msgTyped greeting = "привет"
+
+func demo(user string) {
+	slog.Info(msgStart)                   // want "LML001: log message must start with a lowercase English letter"
+	slog.Info(msgDone)                    // want "LML003: log message must not contain special characters \\(!, \\?, \\.\\.\\.\\) or emoji"
+	slog.Info(msgJoined)                  // want "LML004: log message contains potentially sensitive data"
+	slog.Info(string(msgTyped))           // want "LML002: log message must contain only English text \\(Cyrillic and other scripts are not allowed\\)"
+	slog.Info(messages.Shutdown)          // want "LML001: log message must start with a lowercase English letter"
+	slog.Info(messages.TokenReady)        // want "LML004: log message contains potentially sensitive data"
+	slog.Info(msgPrefix + " for " + user) // want "LML004: log message contains potentially sensitive data"
+
+	slog.Info(msgValid)
+	slog.Info(msgValid + ": " + user)
+}
+
+func stop() {
+	// Повторный вызов репортится, но объявление правит только первая диагностика.
+	slog.Warn(msgStart) // want "LML001: log message must start with a lowercase English letter"
+}
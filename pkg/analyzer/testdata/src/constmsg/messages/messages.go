@@ -0,0 +1,6 @@
+package messages
+
+const (
+	Shutdown   = "Shutting down"
+	TokenReady = "token " + "refreshed"
+)
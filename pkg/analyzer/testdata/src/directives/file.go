@@ -0,0 +1,10 @@
+package directives
+
+//logmsglint:file-ignore english-only -- файл с сообщениями для русскоязычной поддержки
+
+import "log/slog"
+
+func support() {
+	slog.Info("ошибка подключения")
+	slog.Info("сервер запущен!") // want "LML003: log message must not contain special characters"
+}
@@ -0,0 +1,29 @@
+package directives
+
+import "log/slog"
+
+func demo() {
+	slog.Info("request failed!") //logmsglint:ignore no-specials -- текст согласован с алертами
+
+	//logmsglint:ignore LML001,LML003 -- сообщение парсит внешний дашборд
+	slog.Info("Ready?")
+
+	//logmsglint:ignore no-specials -- многострочный вызов подавляется целиком
+	slog.Info(
+		"cache miss?",
+		"attempt", 1,
+	)
+
+	slog.Info(
+		"cache hit!",
+	) //logmsglint:ignore no-specials -- директива в конце закрывающей строки относится к вызову
+	slog.Info("next step!") // want "LML003: log message must not contain special characters"
+
+	slog.Info("Done!") //logmsglint:ignore no-specials -- подавлено только одно правило // want "LML001: log message must start with a lowercase English letter"
+
+	slog.Info("user password reset") //logmsglint:ignore sensitive // want "LML011: suppression directive must give a reason after \"--\"" "LML004: log message contains potentially sensitive data"
+
+	slog.Info("retry!") //logmsglint:ignore no-such-rule -- причина // want "LML011: suppression directive refers to unknown rule \"no-such-rule\"" "LML003: log message must not contain special characters"
+
+	slog.Info("all good") //logmsglint:ignore lowercase -- устаревшая директива // want "LML011: suppression directive for lowercase does not suppress anything"
+}
@@ -20,19 +20,19 @@ func demo() {
 
 	// Проверяем raw string (многострочный литерал): чувствительный маркер token
 	// должен быть найден даже внутри строкового литерала с переносом строки.
-	slog.Info( /* want "лог-сообщение содержит потенциально чувствительные данные" */ "raw: " + `user token leaked
+	slog.Info( /* want "LML004: log message contains potentially sensitive data" */ "raw: " + `user token leaked
 in multiline raw string`)
 
 	// Проверяем сложную конкатенацию: в цепочке из нескольких частей есть
 	// строковый литерал с token, его нужно поймать через обход BinaryExpr.
-	slog.Info("user token: " + prefix + " " + body) // want "лог-сообщение содержит потенциально чувствительные данные"
+	slog.Info("user token: " + prefix + " " + body) // want "LML004: log message contains potentially sensitive data"
 
 	// Проверяем аналогичный кейс для zap.Logger с конкатенацией литерала и переменной.
-	logger.Info("token: " + token) // want "лог-сообщение содержит потенциально чувствительные данные"
+	logger.Info("token: " + token) // want "LML004: log message contains potentially sensitive data"
 
 	// Проверяем форматирование SugaredLogger: восклицательный знак в шаблоне
 	// должен сработать как нарушение по спецсимволам.
-	sugar.Infof("failed to load %s!", filename) // want "лог-сообщение не должно содержать спецсимволы \\(!, \\?, \\.\\.\\.\\) и эмодзи"
+	sugar.Infof("failed to load %s!", filename) // want "LML003: log message must not contain special characters \\(!, \\?, \\.\\.\\.\\) or emoji"
 
 	// Проверяем вызов с не-константным сообщением: линтер должен безопасно
 	// пропустить такой случай и не падать.
@@ -0,0 +1,20 @@
+package extrasinks
+
+import (
+	"context"
+
+	"github.com/acme/obs"
+)
+
+func demo(ctx context.Context, logger *obs.Logger, user string) {
+	logger.Info(ctx, "User logged in")             // want "LML001: log message must start with a lowercase English letter"
+	obs.Infof(ctx, "token for %s issued", user)    // want "LML004: log message contains potentially sensitive data"
+	obs.Errorf(ctx, "connection refused!")         // want "LML003: log message must not contain special characters \\(!, \\?, \\.\\.\\.\\) or emoji"
+	(*obs.Logger).Info(logger, ctx, "Method expr") // want "LML001: log message must start with a lowercase English letter"
+
+	// Debug не перечислен в конфигурации и не проверяется.
+	logger.Debug(ctx, "Not configured!")
+
+	logger.Info(ctx, "user logged in")
+	obs.Infof(ctx, "request %s served", user)
+}
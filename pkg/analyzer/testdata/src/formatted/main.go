@@ -0,0 +1,36 @@
+package formatted
+
+import (
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+const greeting = "Hello"
+
+func demo(name string, parts []string) {
+	logger := zap.NewNop()
+
+	slog.Info(fmt.Sprintf("User %s logged in!", name))         // want "LML001: log message must start with a lowercase English letter" "LML003: log message must not contain special characters \\(!, \\?, \\.\\.\\.\\) or emoji"
+	slog.Info(fmt.Sprintf("user %s has %s", name, "token"))    // want "LML004: log message contains potentially sensitive data"
+	slog.Warn(fmt.Sprint("Cache ", "miss?"))                   // want "LML001: log message must start with a lowercase English letter" "LML003: log message must not contain special characters \\(!, \\?, \\.\\.\\.\\) or emoji"
+	slog.Error(fmt.Sprintln("ошибка", name))                   // want "LML002: log message must contain only English text \\(Cyrillic and other scripts are not allowed\\)"
+	slog.Info(strings.Join([]string{"Loaded", "config"}, " ")) // want "LML001: log message must start with a lowercase English letter"
+	slog.Info(strings.Join([]string{"user", "api_key"}, ": ")) // want "LML004: log message contains potentially sensitive data"
+	logger.Info(errors.New("Connection reset").Error())        // want "LML001: log message must start with a lowercase English letter"
+	logger.Info(fmt.Sprintf(greeting+" %s", name))             // want "LML001: log message must start with a lowercase English letter"
+
+	// Строчная буква обязательна только для начала сообщения: аргументы Sprintf
+	// и не первые элементы не проверяются на регистр.
+	slog.Info(fmt.Sprintf("user %s logged in", "Alice"))
+	slog.Info(fmt.Sprint(name, "Logged in"))
+	slog.Info(name + "Logged in")
+	slog.Info("prefix: " + fmt.Sprintf(greeting+" %s", name))
+
+	// Нелитеральные аргументы и слайсы не разбираются.
+	slog.Info(strings.Join(parts, " "))
+	slog.Info(fmt.Sprintf(name, parts))
+}
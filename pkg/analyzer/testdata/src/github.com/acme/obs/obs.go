@@ -0,0 +1,11 @@
+package obs
+
+import "context"
+
+type Logger struct{}
+
+func (l *Logger) Info(ctx context.Context, msg string, args ...any)  {}
+func (l *Logger) Debug(ctx context.Context, msg string, args ...any) {}
+
+func Infof(ctx context.Context, format string, args ...any)  {}
+func Errorf(ctx context.Context, format string, args ...any) {}
@@ -0,0 +1,12 @@
+package log
+
+import "github.com/rs/zerolog"
+
+var Logger = zerolog.New()
+
+func Info() *zerolog.Event  { return Logger.Info() }
+func Warn() *zerolog.Event  { return Logger.Warn() }
+func Error() *zerolog.Event { return Logger.Error() }
+
+func Print(...any)          {}
+func Printf(string, ...any) {}
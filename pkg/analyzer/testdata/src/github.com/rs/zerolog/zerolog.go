@@ -0,0 +1,25 @@
+package zerolog
+
+type Logger struct{}
+type Event struct{}
+
+func New() Logger { return Logger{} }
+
+func (l Logger) With() Context          { return Context{} }
+func (l *Logger) Info() *Event          { return &Event{} }
+func (l *Logger) Warn() *Event          { return &Event{} }
+func (l *Logger) Error() *Event         { return &Event{} }
+func (l *Logger) Print(...any)          {}
+func (l *Logger) Printf(string, ...any) {}
+
+type Context struct{}
+
+func (c Context) Str(string, string) Context { return c }
+func (c Context) Logger() Logger             { return Logger{} }
+
+func (e *Event) Str(string, string) *Event { return e }
+func (e *Event) Int(string, int) *Event    { return e }
+func (e *Event) Err(error) *Event          { return e }
+func (e *Event) Msg(string)                {}
+func (e *Event) Msgf(string, ...any)       {}
+func (e *Event) Send()                     {}
@@ -0,0 +1,77 @@
+package logrus
+
+type Level uint32
+
+const InfoLevel Level = 4
+
+type Fields map[string]any
+
+type Logger struct{}
+type Entry struct{}
+
+type FieldLogger interface {
+	WithField(key string, value any) *Entry
+	WithFields(fields Fields) *Entry
+	WithError(err error) *Entry
+
+	Infof(format string, args ...any)
+	Warnf(format string, args ...any)
+	Errorf(format string, args ...any)
+
+	Info(args ...any)
+	Warn(args ...any)
+	Error(args ...any)
+
+	Infoln(args ...any)
+	Warnln(args ...any)
+	Errorln(args ...any)
+}
+
+type Ext1FieldLogger interface {
+	FieldLogger
+	Tracef(format string, args ...any)
+	Trace(args ...any)
+	Traceln(args ...any)
+}
+
+func New() *Logger                           { return &Logger{} }
+func StandardLogger() *Logger                { return &Logger{} }
+func WithField(key string, value any) *Entry { return &Entry{} }
+func Info(args ...any)                       {}
+func Infof(format string, args ...any)       {}
+func Infoln(args ...any)                     {}
+
+func (l *Logger) WithField(key string, value any) *Entry       { return &Entry{} }
+func (l *Logger) WithFields(fields Fields) *Entry              { return &Entry{} }
+func (l *Logger) WithError(err error) *Entry                   { return &Entry{} }
+func (l *Logger) Infof(format string, args ...any)             {}
+func (l *Logger) Warnf(format string, args ...any)             {}
+func (l *Logger) Errorf(format string, args ...any)            {}
+func (l *Logger) Tracef(format string, args ...any)            {}
+func (l *Logger) Logf(level Level, format string, args ...any) {}
+func (l *Logger) Info(args ...any)                             {}
+func (l *Logger) Warn(args ...any)                             {}
+func (l *Logger) Error(args ...any)                            {}
+func (l *Logger) Trace(args ...any)                            {}
+func (l *Logger) Log(level Level, args ...any)                 {}
+func (l *Logger) Infoln(args ...any)                           {}
+func (l *Logger) Warnln(args ...any)                           {}
+func (l *Logger) Errorln(args ...any)                          {}
+func (l *Logger) Traceln(args ...any)                          {}
+
+func (e *Entry) WithField(key string, value any) *Entry { return e }
+func (e *Entry) WithFields(fields Fields) *Entry        { return e }
+func (e *Entry) WithError(err error) *Entry             { return e }
+func (e *Entry) String() (string, error)                { return "", nil }
+func (e *Entry) Infof(format string, args ...any)       {}
+func (e *Entry) Warnf(format string, args ...any)       {}
+func (e *Entry) Errorf(format string, args ...any)      {}
+func (e *Entry) Tracef(format string, args ...any)      {}
+func (e *Entry) Info(args ...any)                       {}
+func (e *Entry) Warn(args ...any)                       {}
+func (e *Entry) Error(args ...any)                      {}
+func (e *Entry) Trace(args ...any)                      {}
+func (e *Entry) Infoln(args ...any)                     {}
+func (e *Entry) Warnln(args ...any)                     {}
+func (e *Entry) Errorln(args ...any)                    {}
+func (e *Entry) Traceln(args ...any)                    {}
@@ -1,5 +1,7 @@
 package zap
 
+import "go.uber.org/zap/zapcore"
+
 type Logger struct{}
 type SugaredLogger struct{}
 type Field struct{}
@@ -9,9 +11,23 @@ type Level int8
 func NewNop() *Logger { return &Logger{} }
 
 func (l *Logger) Sugar() *SugaredLogger { return &SugaredLogger{} }
+func (l *Logger) With(...Field) *Logger { return l }
 
 func (l *Logger) Info(string, ...Field) {}
 func (l *Logger) Warn(string, ...Field) {}
 
-func (s *SugaredLogger) Infof(string, ...any) {}
-func (s *SugaredLogger) Infow(string, ...any) {}
+func (s *SugaredLogger) With(...any) *SugaredLogger { return s }
+
+func (s *SugaredLogger) Infof(string, ...any)  {}
+func (s *SugaredLogger) Infow(string, ...any)  {}
+func (s *SugaredLogger) Errorw(string, ...any) {}
+
+func String(key string, val string) Field { return Field{} }
+func Int(key string, val int) Field       { return Field{} }
+func Any(key string, val any) Field       { return Field{} }
+func Reflect(key string, val any) Field   { return Field{} }
+func Object(key string, val zapcore.ObjectMarshaler) Field {
+	return Field{}
+}
+func Namespace(key string) Field { return Field{} }
+func Error(err error) Field      { return Field{} }
@@ -0,0 +1,9 @@
+package zapcore
+
+type ObjectEncoder interface {
+	AddString(key, value string)
+}
+
+type ObjectMarshaler interface {
+	MarshalLogObject(ObjectEncoder) error
+}
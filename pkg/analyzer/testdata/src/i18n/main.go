@@ -0,0 +1,10 @@
+package i18n
+
+import "log/slog"
+
+func demo(password string) {
+	slog.Info("Starting server")         // want "LML001: лог-сообщение должно начинаться со строчной английской буквы"
+	slog.Info("запуск сервера")          // want "LML002: лог-сообщение должно содержать только английский текст"
+	slog.Info("server started!")         // want "LML003: лог-сообщение не должно содержать спецсимволы"
+	slog.Info("login", "pass", password) // want "LML006: аргумент лога содержит потенциально чувствительные данные"
+}
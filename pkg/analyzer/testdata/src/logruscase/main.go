@@ -0,0 +1,41 @@
+package logruscase
+
+import (
+	"errors"
+
+	"github.com/sirupsen/logrus"
+)
+
+// service получает логгер через интерфейс, поэтому вызовы резолвятся
+// в методы logrus.FieldLogger, а не в конкретный тип.
+type service struct {
+	log logrus.FieldLogger
+}
+
+// tracer встраивает интерфейс logrus в собственный интерфейс пользователя.
+type tracer interface {
+	logrus.Ext1FieldLogger
+}
+
+func demo(user string, s service, t tracer) {
+	logger := logrus.New()
+	err := errors.New("boom")
+
+	logger.Info("Service started")                           // want "LML001: log message must start with a lowercase English letter"
+	logger.Infof("user %s logged in!", user)                 // want "LML003: log message must not contain special characters \\(!, \\?, \\.\\.\\.\\) or emoji"
+	logger.Infoln(user, "Password changed")                  // want "LML001: log message must start with a lowercase English letter" "LML004: log message contains potentially sensitive data"
+	logger.WithField("user", user).Error("ошибка входа")     // want "LML002: log message must contain only English text \\(Cyrillic and other scripts are not allowed\\)"
+	logger.WithError(err).Warnf("token %s expired", user)    // want "LML004: log message contains potentially sensitive data"
+	logger.Logf(logrus.InfoLevel, "Cache warmed")            // want "LML001: log message must start with a lowercase English letter"
+	logger.Log(logrus.InfoLevel, "Queue drained")            // want "LML001: log message must start with a lowercase English letter"
+	logrus.Infof("Listening on %d", 8080)                    // want "LML001: log message must start with a lowercase English letter"
+	logrus.WithField("user", user).Info("api_key rotated")   // want "LML004: log message contains potentially sensitive data"
+	s.log.Errorf("Failed to connect")                        // want "LML001: log message must start with a lowercase English letter"
+	s.log.WithFields(logrus.Fields{"k": 1}).Warnln("retry?") // want "LML003: log message must not contain special characters \\(!, \\?, \\.\\.\\.\\) or emoji"
+	t.Info("Tracing enabled")                                // want "LML001: log message must start with a lowercase English letter"
+	t.Tracef("secret rotated")                               // want "LML004: log message contains potentially sensitive data"
+
+	logger.Info("service started")
+	s.log.WithField("user", user).Infof("user %s logged in", user)
+	t.Traceln(user, 42)
+}
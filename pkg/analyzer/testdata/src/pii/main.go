@@ -0,0 +1,28 @@
+package pii
+
+import (
+	"log/slog"
+
+	"go.uber.org/zap"
+)
+
+func demo(userID string) {
+	logger := zap.NewNop()
+
+	slog.Info("sent invite to john.doe@corp.io")                  // want `LML009: log message contains personal data: email`
+	slog.Info("connection from 10.12.0.7 accepted")               // want `LML009: log message contains personal data: IP address`
+	slog.Info("connection from 2a00:1450:4001:81b::200e dropped") // want `LML009: log message contains personal data: IP address`
+	logger.Info("calling +14155552671 for verification")          // want `LML009: log message contains personal data: phone number`
+	logger.Warn("charge failed for 4539 1488 0343 6467")          // want `LML009: log message contains personal data: card number`
+	slog.Info("user " + userID + " wrote to ops@corp.io")         // want `LML009: log message contains personal data: email`
+
+	slog.Info("sent invite to jane@example.com")
+	slog.Info("sent invite to jane@mail.internal.test")
+	slog.Info("connection from 192.0.2.15 accepted")
+	slog.Info("connection from 2001:db8::1 accepted")
+	slog.Info("connection from 127.0.0.1 accepted")
+	slog.Info("listening on 0.0.0.0 and :: with 10.1.2.3 allowed")
+	slog.Info("support line +18005550199 is public")
+	slog.Info("order 4539 1488 0343 6468 shipped")
+	slog.Info("build 1.22.3 released at 12:30:45")
+}
@@ -0,0 +1,26 @@
+package punctuation
+
+import (
+	"fmt"
+	"log"
+	"log/slog"
+)
+
+func demo(name string) {
+	slog.Info("server started.")                  // want "LML003: log message must not contain special characters"
+	slog.Info("server  started")                  // want "LML003: log message must not contain special characters"
+	slog.Info("server\tstarted")                  // want "LML003: log message must not contain special characters"
+	slog.Info("server\nstarted")                  // want "LML003: log message must not contain special characters"
+	slog.Info("cache hit; ratio high")            // want "LML003: log message must not contain special characters"
+	slog.Info("deploy finished 🚀")                // want "LML003: log message must not contain special characters"
+	slog.Info("region 🇩🇪 ready")                  // want "LML003: log message must not contain special characters"
+	slog.Info("approved 👍🏽 by reviewer")          // want "LML003: log message must not contain special characters"
+	slog.Info("dev 👨‍💻 deployed")                 // want "LML003: log message must not contain special characters"
+	slog.Info(fmt.Sprintf("user %s left.", name)) // want "LML003: log message must not contain special characters"
+	slog.Info("version 1." + name)
+	log.Printf("request finished\n")
+	slog.Info("ready? yes")
+	slog.Info("done!")
+	slog.Info("acme© widget™ brand®")
+	slog.Info("sync a↔b, next→ step ←back")
+}
@@ -0,0 +1,11 @@
+package rules
+
+import "log/slog"
+
+func demo(password string) {
+	slog.Info("Starting server") // want `LML001: log message must start with a lowercase English letter`
+	slog.Info("запуск сервера")
+	slog.Info("server started!")
+	slog.Info("user password reset") // want `LML004: log message contains potentially sensitive data`
+	slog.Info("login", "pass", password)
+}
@@ -0,0 +1,13 @@
+package scripts
+
+import "log/slog"
+
+func demo() {
+	slog.Info("zażółć gęślą jaźń completed")
+	slog.Info("größe der datei geprüft")
+	slog.Info("variance σ exceeds threshold")
+	slog.Info("latency 5µs")        // µ разрешен через allowed-runes
+	slog.Info("ошибка подключения") // want "LML002: log message must contain only English text"
+	slog.Info("job failed 数据库")     // want "LML002: log message must contain only English text"
+	slog.Info("cache miss ЩИ")      // want "LML002: log message must contain only English text"
+}
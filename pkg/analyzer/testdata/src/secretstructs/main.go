@@ -0,0 +1,132 @@
+package secretstructs
+
+import (
+	"fmt"
+	"log"
+	"log/slog"
+
+	"go.uber.org/zap"
+	"go.uber.org/zap/zapcore"
+)
+
+type User struct {
+	ID       int
+	Name     string
+	Password string
+}
+
+type Credentials struct {
+	Login  string
+	Secret string
+}
+
+type Config struct {
+	Addr        string
+	Credentials Credentials
+}
+
+type Session struct {
+	ID      string
+	Payload string `log:"-"`
+}
+
+type Limits struct {
+	TokenTTL int
+	Retries  int
+}
+
+type SafeUser struct {
+	Name     string
+	Password string
+}
+
+func (u SafeUser) LogValue() slog.Value { return slog.StringValue(u.Name) }
+
+type MarshaledUser struct {
+	Name  string
+	Token string
+}
+
+func (u *MarshaledUser) MarshalLogObject(enc zapcore.ObjectEncoder) error {
+	enc.AddString("name", u.Name)
+	return nil
+}
+
+type Account struct {
+	Owner    string
+	APIKey   string
+	Children []*Account
+}
+
+func (a Account) String() string { return a.Owner }
+
+type PtrValuer struct {
+	Name   string
+	Secret string
+}
+
+func (p *PtrValuer) LogValue() slog.Value { return slog.StringValue(p.Name) }
+
+type RedactedError struct {
+	User     string
+	Password string
+}
+
+func (e *RedactedError) Error() string { return "login failed for " + e.User }
+
+type FormattedToken struct {
+	Token string
+}
+
+func (FormattedToken) Format(f fmt.State, verb rune) { fmt.Fprint(f, "[redacted]") }
+
+type Envelope struct {
+	Addr   string
+	Config *Config
+}
+
+type Holder struct {
+	Public  Account
+	private Account
+}
+
+func demo(u User, cfg *Config, s Session, l Limits, safe SafeUser, mu *MarshaledUser, accounts []Account, pv PtrValuer, re *RedactedError, ft FormattedToken, env Envelope, h Holder) {
+	logger := zap.NewNop()
+	sugar := logger.Sugar()
+
+	slog.Info("user loaded", slog.Any("user", u))      // want `LML007: struct with a sensitive field is logged as a whole: Password`
+	slog.Info("config loaded", slog.Any("cfg", cfg))   // want `LML007: struct with a sensitive field is logged as a whole: Credentials.Secret`
+	logger.Info("user loaded", zap.Any("user", &u))    // want `LML007: struct with a sensitive field is logged as a whole: Password`
+	logger.Info("session loaded", zap.Reflect("s", s)) // want `LML007: struct with a sensitive field is logged as a whole: Payload`
+	sugar.Infof("user %+v loaded", u)                  // want `LML007: struct with a sensitive field is logged as a whole: Password`
+	log.Printf("config %d %v", 1, []Config{*cfg})      // want `LML007: struct with a sensitive field is logged as a whole: Credentials.Secret`
+	sugar.Infof("config %+v", cfg)                     // want `LML007: struct with a sensitive field is logged as a whole: Credentials.Secret`
+
+	// Метод вывода учитывается только тем конструктором, который его вызывает.
+	slog.Info("account loaded", slog.Any("account", accounts[0])) // want `LML007: struct with a sensitive field is logged as a whole: APIKey`
+	sugar.Infof("account %#v", accounts[0])                       // want `LML007: struct with a sensitive field is logged as a whole: APIKey`
+	sugar.Infof("holder %v", h)                                   // want `LML007: struct with a sensitive field is logged as a whole: private.APIKey`
+	logger.Info("user loaded", zap.Any("user", safe))             // want `LML007: struct with a sensitive field is logged as a whole: Password`
+	logger.Info("user loaded", zap.Reflect("user", safe))         // want `LML007: struct with a sensitive field is logged as a whole: Password`
+	logger.Info("user loaded", zap.Reflect("user", mu))           // want `LML007: struct with a sensitive field is logged as a whole: Token`
+	slog.Info("user loaded", slog.Any("user", mu))                // want `LML007: struct with a sensitive field is logged as a whole: Token`
+	slog.Info("user loaded", slog.Any("user", pv))                // want `LML007: struct with a sensitive field is logged as a whole: Secret`
+
+	slog.Info("limits loaded", slog.Any("limits", l))
+	slog.Info("user loaded", slog.Any("user", safe))
+	slog.Info("user loaded", slog.Any("user", &safe))
+	slog.Info("user loaded", slog.Any("user", &pv))
+	logger.Info("user loaded", zap.Object("user", mu))
+	logger.Info("user loaded", zap.Any("user", mu))
+	sugar.Infof("user %s loaded", u.Name)
+
+	// fmt вызывает String, Error и Format, а вложенные указатели печатает адресами.
+	sugar.Infof("accounts %v", accounts)
+	sugar.Infof("account %+v", accounts[0])
+	sugar.Infof("login %v", re)
+	sugar.Infof("value %+v", ft)
+	sugar.Infof("envelope %+v", env)
+	log.Printf("configs %v", []*Config{cfg})
+	sugar.Infof("user %d loaded", u.ID)
+	_ = fmt.Sprintf("user %v", u)
+}
@@ -0,0 +1,14 @@
+package sensitiveallow
+
+import "log/slog"
+
+func demo() {
+	slog.Info("token bucket refilled")
+	slog.Info("rate limiter: Token Bucket refilled")
+	slog.Info("parsed next token")
+	slog.Info("secret rotation scheduled")
+	slog.Info("token bucket refilled, token expired")        // want "LML004: log message contains potentially sensitive data"
+	slog.Info("secret manager unavailable")                  // want "LML004: log message contains potentially sensitive data"
+	slog.Info("next token", "password", "x")                 // want "LML005: log attribute key contains potentially sensitive data"
+	slog.Info("password and token bucket missing in config") // want "LML004: log message contains potentially sensitive data"
+}
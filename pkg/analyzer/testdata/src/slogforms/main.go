@@ -0,0 +1,48 @@
+package slogforms
+
+import (
+	"context"
+	"log/slog"
+)
+
+var warn = slog.Warn
+
+func demo(ctx context.Context, logger *slog.Logger, user string) {
+	// Логгер, полученный через With, остается *slog.Logger.
+	logger.With("user", user).Info("User logged in") // want "LML001: log message must start with a lowercase English letter"
+	logger.WithGroup("auth").Warn("token expired")   // want "LML004: log message contains potentially sensitive data"
+
+	// Логгер по умолчанию и Context-варианты методов.
+	slog.Default().InfoContext(ctx, "Cache warmed")                 // want "LML001: log message must start with a lowercase English letter"
+	slog.Default().Log(ctx, slog.LevelInfo, "ready?")               // want "LML003: log message must not contain special characters \\(!, \\?, \\.\\.\\.\\) or emoji"
+	slog.Default().With("k", 1).ErrorContext(ctx, "ошибка", "k", 2) // want "LML002: log message must contain only English text \\(Cyrillic and other scripts are not allowed\\)"
+
+	// Method value, сохраненный в переменную.
+	f := logger.Warn
+	f("X!") // want "LML001: log message must start with a lowercase English letter" "LML003: log message must not contain special characters \\(!, \\?, \\.\\.\\.\\) or emoji"
+
+	g := logger.With("k", 1).InfoContext
+	g(ctx, "Started") // want "LML001: log message must start with a lowercase English letter"
+
+	// Функция пакета, сохраненная в переменную.
+	warn("password reset") // want "LML004: log message contains potentially sensitive data"
+
+	// Method expression: получатель идет первым аргументом.
+	(*slog.Logger).Info(logger, "X")                     // want "LML001: log message must start with a lowercase English letter"
+	(*slog.Logger).ErrorContext(logger, ctx, "failed!")  // want "LML003: log message must not contain special characters \\(!, \\?, \\.\\.\\.\\) or emoji"
+	(*slog.Logger).Log(logger, ctx, slog.LevelWarn, "X") // want "LML001: log message must start with a lowercase English letter"
+
+	h := (*slog.Logger).Debug
+	h(logger, "api_key rotated") // want "LML004: log message contains potentially sensitive data"
+
+	// Переменная, которой присваиваются разные функции, не анализируется.
+	dynamic := logger.Info
+	if user == "" {
+		dynamic = func(string, ...any) {}
+	}
+	dynamic("Maybe logged")
+
+	f("valid message")
+	(*slog.Logger).Info(logger, "valid message")
+	logger.With("user", user).Info("user logged in")
+}
@@ -0,0 +1,31 @@
+package stdlog
+
+import (
+	"log"
+	"log/slog"
+	"os"
+)
+
+func demo(name string) { // want demo:"messageSink\\(0\\)"
+	logger := log.New(os.Stderr, "", 0)
+	bridged := slog.NewLogLogger(slog.Default().Handler(), slog.LevelInfo)
+
+	log.Printf("Starting worker %d", 1)   // want "LML001: log message must start with a lowercase English letter"
+	log.Println("token=" + name)          // want "LML004: log message contains potentially sensitive data"
+	log.Print("request failed!")          // want "LML003: log message must not contain special characters \\(!, \\?, \\.\\.\\.\\) or emoji"
+	log.Fatalf("ошибка %v", name)         // want "LML002: log message must contain only English text \\(Cyrillic and other scripts are not allowed\\)"
+	log.Panicln(name, "Password expired") // want "LML001: log message must start with a lowercase English letter" "LML004: log message contains potentially sensitive data"
+	log.Output(2, "Done")                 // want "LML001: log message must start with a lowercase English letter"
+
+	logger.Printf("cache miss?")  // want "LML003: log message must not contain special characters \\(!, \\?, \\.\\.\\.\\) or emoji"
+	logger.Println(42, "Retry")   // want "LML001: log message must start with a lowercase English letter"
+	bridged.Print("api_key sent") // want "LML004: log message contains potentially sensitive data"
+
+	// Без строковых литералов сообщение не определяется, и линтер молчит.
+	// logger.Print(name) при этом делает demo оберткой: факт выше.
+	log.Println(name, 42)
+	logger.Print(name)
+
+	log.Printf("worker %s started", name)
+	logger.Println("cache warmed up")
+}
@@ -0,0 +1,62 @@
+package taint
+
+import (
+	"fmt"
+	"log/slog"
+	"net/http"
+	"os"
+	"strings"
+
+	"go.uber.org/zap"
+)
+
+type dbConfig struct {
+	Host           string
+	Password       string
+	DSN            string `log:"-"`
+	TokenLength    int
+	PasswordMinLen int
+}
+
+type vault struct{}
+
+func (vault) Fetch(name string) string { return name }
+
+func handle(r *http.Request, cfg dbConfig, v vault) {
+	logger := zap.NewNop()
+
+	dsn := os.Getenv("DB_PASSWORD")
+	slog.Info("conn", "dsn", dsn) // want "LML010: value from a secret source reaches the log: log/slog.Info"
+
+	auth := r.Header.Get("Authorization")
+	line := fmt.Sprintf("header=%s", strings.TrimSpace(auth))
+	logger.Info("request", zap.String("h", line)) // want "LML010: value from a secret source reaches the log: \\(\\*go.uber.org/zap.Logger\\).Info"
+
+	pw := cfg.Password
+	slog.Default().With("p", pw).Info("connected") // want "LML010: value from a secret source reaches the log: \\(\\*log/slog.Logger\\).Info"
+
+	conn := cfg.DSN
+	if conn == "" {
+		conn = "local"
+	}
+	slog.Warn("using " + conn) // want "LML010: value from a secret source reaches the log: log/slog.Warn"
+
+	key, _ := os.LookupEnv("STRIPE_API_KEY")
+	args := []any{"k"}
+	args = append(args, key)
+	slog.Info("stripe", args...) // want "LML010: value from a secret source reaches the log: log/slog.Info"
+
+	// Настроенный в конфигурации источник.
+	slog.Info("fetched", "v", v.Fetch("db")) // want "LML010: value from a secret source reaches the log: log/slog.Info"
+
+	// Несекретные переменные окружения, заголовки и поля не помечаются.
+	slog.Info("env", "home", os.Getenv("HOME"))
+	slog.Info("header", "ua", r.Header.Get("User-Agent"))
+	slog.Info("db", "host", cfg.Host)
+
+	// Числовые поля с "секретными" именами источниками не считаются.
+	slog.Info("policy", "len", cfg.TokenLength, "min", cfg.PasswordMinLen)
+
+	// Секрет, который не доходит до логгера, не репортится.
+	_ = strings.ToUpper(dsn)
+}
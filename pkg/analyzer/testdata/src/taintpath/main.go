@@ -0,0 +1,12 @@
+package taintpath
+
+import (
+	"log/slog"
+	"os"
+)
+
+func connect() {
+	dsn := os.Getenv("DB_PASSWORD")
+	line := "dsn=" + dsn
+	slog.Info("connecting", "line", line) // want "LML010: value from a secret source reaches the log: log/slog.Info"
+}
@@ -0,0 +1,23 @@
+package app
+
+import (
+	"context"
+
+	"wrappers/mid"
+	"wrappers/obslog"
+)
+
+func demo(ctx context.Context, logger *obslog.Logger, user string) {
+	obslog.Infof(ctx, "User %s logged in", user) // want "LML001: log message must start with a lowercase English letter"
+	obslog.Warn("disk almost full!")             // want "LML003: log message must not contain special characters \\(!, \\?, \\.\\.\\.\\) or emoji"
+	obslog.Legacy("password rotated")            // want "LML004: log message contains potentially sensitive data"
+	logger.Info("auth", "Token refreshed")       // want "LML001: log message must start with a lowercase English letter" "LML004: log message contains potentially sensitive data"
+	mid.Notice(ctx, "ошибка кэша")               // want "LML002: log message must contain only English text \\(Cyrillic and other scripts are not allowed\\)"
+
+	obslog.Annotate("Not a wrapper!")
+	obslog.Tagged("Not a message!")
+	obslog.Reset("Not a message either!")
+
+	obslog.Infof(ctx, "user %s logged in", user)
+	mid.Notice(ctx, "cache warmed")
+}
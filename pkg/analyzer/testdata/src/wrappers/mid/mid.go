@@ -0,0 +1,12 @@
+package mid
+
+import (
+	"context"
+
+	"wrappers/obslog"
+)
+
+// Notice оборачивает обертку из другого пакета.
+func Notice(ctx context.Context, text string) { // want Notice:"messageSink\\(1\\)"
+	obslog.Infof(ctx, text)
+}
@@ -0,0 +1,50 @@
+package obslog
+
+import (
+	"context"
+	"log"
+	"log/slog"
+)
+
+// Infof пробрасывает format прямо в сообщение slog.
+func Infof(ctx context.Context, format string, args ...any) { // want Infof:"messageSink\\(1\\)"
+	slog.InfoContext(ctx, format, args...)
+}
+
+// Warn вызывает обертку, объявленную ниже по файлу.
+func Warn(msg string) { // want Warn:"messageSink\\(0\\)"
+	errorf(context.Background(), msg)
+}
+
+func errorf(ctx context.Context, format string) { // want errorf:"messageSink\\(1\\)"
+	slog.ErrorContext(ctx, format)
+}
+
+// Legacy пишет через стандартный log.
+func Legacy(msg string) { // want Legacy:"messageSink\\(0\\)"
+	log.Println(msg)
+}
+
+type Logger struct {
+	l *slog.Logger
+}
+
+func (l *Logger) Info(component, msg string, kv ...any) { // want Info:"messageSink\\(1\\)"
+	l.l.With("component", component).Info(msg, kv...)
+}
+
+// Annotate модифицирует сообщение перед логированием и оберткой не считается.
+func Annotate(msg string) {
+	slog.Info("annotated: " + msg)
+}
+
+// Tagged передает параметр не в позицию сообщения.
+func Tagged(tag string) {
+	slog.Info("tagged", "tag", tag)
+}
+
+// Reset переписывает параметр перед логированием и оберткой не считается.
+func Reset(msg string) {
+	msg = "state reset"
+	slog.Info(msg)
+}
@@ -0,0 +1,29 @@
+package zerologcase
+
+import (
+	"errors"
+
+	"github.com/rs/zerolog"
+	"github.com/rs/zerolog/log"
+)
+
+func demo(user string) {
+	logger := zerolog.New()
+	err := errors.New("boom")
+
+	log.Info().Str("user", user).Msg("User logged in")    // want "LML001: log message must start with a lowercase English letter"
+	log.Warn().Msgf("retry %d failed!", 3)                // want "LML003: log message must not contain special characters \\(!, \\?, \\.\\.\\.\\) or emoji"
+	log.Error().Err(err).Msg("ошибка подключения")        // want "LML002: log message must contain only English text \\(Cyrillic and other scripts are not allowed\\)"
+	logger.Info().Int("attempt", 1).Msg("token: " + user) // want "LML004: log message contains potentially sensitive data"
+
+	child := logger.With().Str("component", "auth").Logger()
+	child.Error().Msg("Failed")    // want "LML001: log message must start with a lowercase English letter"
+	child.Printf("password reset") // want "LML004: log message contains potentially sensitive data"
+	log.Print(user, "Started")     // want "LML001: log message must start with a lowercase English letter"
+
+	// Info() без Msg не несет сообщения, а Send завершает цепочку без текста.
+	log.Info().Str("user", user).Send()
+
+	log.Info().Str("user", user).Msg("user logged in")
+	logger.Warn().Msgf("retry %d scheduled", 3)
+}
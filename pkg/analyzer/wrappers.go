@@ -0,0 +1,178 @@
+package analyzer
+
+import (
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+
+	"golang.org/x/tools/go/analysis"
+)
+
+// messageSinkFact помечает функцию-обертку, которая передает свой строковый
+// параметр с индексом ArgIndex прямо в сообщение известного логгера.
+// Факт экспортируется между пакетами, поэтому вызовы обертки проверяются
+// и в пакетах, которые ее импортируют.
+type messageSinkFact struct {
+	ArgIndex int
+}
+
+func (*messageSinkFact) AFact() {}
+
+func (f *messageSinkFact) String() string {
+	return fmt.Sprintf("messageSink(%d)", f.ArgIndex)
+}
+
+// inferWrappers находит функции пакета, которые пробрасывают строковый параметр
+// в сообщение логгера, и экспортирует для них messageSinkFact.
+// Обертка может вызывать другую обертку того же пакета, объявленную ниже
+// по файлу, поэтому повторяем проход, пока появляются новые факты.
+func inferWrappers(pass *analysis.Pass, cfg *settings, values funcValues) {
+	var decls []*ast.FuncDecl
+	for _, file := range pass.Files {
+		for _, decl := range file.Decls {
+			fd, ok := decl.(*ast.FuncDecl)
+			if ok && fd.Body != nil {
+				decls = append(decls, fd)
+			}
+		}
+	}
+
+	for changed := true; changed; {
+		changed = false
+		for _, fd := range decls {
+			fn, ok := pass.TypesInfo.Defs[fd.Name].(*types.Func)
+			if !ok || pass.ImportObjectFact(fn, new(messageSinkFact)) {
+				continue
+			}
+
+			idx, ok := wrappedParamIndex(pass, cfg, values, fd, fn)
+			if !ok {
+				continue
+			}
+
+			pass.ExportObjectFact(fn, &messageSinkFact{ArgIndex: idx})
+			changed = true
+		}
+	}
+}
+
+// wrappedParamIndex возвращает индекс строкового параметра fn, который
+// без изменений попадает в позицию сообщения какого-либо логгера.
+func wrappedParamIndex(pass *analysis.Pass, cfg *settings, values funcValues, fd *ast.FuncDecl, fn *types.Func) (int, bool) {
+	sig := fn.Type().(*types.Signature)
+	params := make(map[*types.Var]int, sig.Params().Len())
+	for i := 0; i < sig.Params().Len(); i++ {
+		param := sig.Params().At(i)
+		if sig.Variadic() && i == sig.Params().Len()-1 {
+			continue
+		}
+		if basic, ok := param.Type().Underlying().(*types.Basic); ok && basic.Info()&types.IsString != 0 {
+			params[param] = i
+		}
+	}
+	// Переприсвоенный параметр уже не несет аргумент вызова: msg = "x"; slog.Info(msg).
+	for param := range reassignedVars(pass, fd.Body) {
+		delete(params, param)
+	}
+	if len(params) == 0 {
+		return 0, false
+	}
+
+	found, idx := false, 0
+	ast.Inspect(fd.Body, func(node ast.Node) bool {
+		if found {
+			return false
+		}
+
+		call, ok := node.(*ast.CallExpr)
+		if !ok {
+			return true
+		}
+
+		arg, ok := messageArg(pass, cfg, values, call)
+		if !ok {
+			return true
+		}
+
+		ident, ok := stripParens(arg).(*ast.Ident)
+		if !ok {
+			return true
+		}
+
+		param, ok := pass.TypesInfo.Uses[ident].(*types.Var)
+		if !ok {
+			return true
+		}
+
+		idx, found = params[param]
+		return !found
+	})
+
+	return idx, found
+}
+
+// reassignedVars собирает переменные, которым в теле функции присваивается
+// новое значение или у которых берется адрес (через указатель их тоже можно изменить).
+func reassignedVars(pass *analysis.Pass, body *ast.BlockStmt) map[*types.Var]struct{} {
+	vars := make(map[*types.Var]struct{})
+	mark := func(expr ast.Expr) {
+		ident, ok := stripParens(expr).(*ast.Ident)
+		if !ok {
+			return
+		}
+		if v, ok := pass.TypesInfo.Uses[ident].(*types.Var); ok {
+			vars[v] = struct{}{}
+		}
+	}
+
+	ast.Inspect(body, func(node ast.Node) bool {
+		switch node := node.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range node.Lhs {
+				mark(lhs)
+			}
+		case *ast.RangeStmt:
+			if node.Tok == token.ASSIGN {
+				mark(node.Key)
+				mark(node.Value)
+			}
+		case *ast.UnaryExpr:
+			if node.Op == token.AND {
+				mark(node.X)
+			}
+		}
+		return true
+	})
+	return vars
+}
+
+// messageArg возвращает выражение в позиции сообщения без требования, чтобы
+// в нем были строковые литералы.
+func messageArg(pass *analysis.Pass, cfg *settings, values funcValues, call *ast.CallExpr) (ast.Expr, bool) {
+	target, ok := calledFunction(pass, values, call)
+	if !ok {
+		return nil, false
+	}
+
+	msgIndex, ok := sinkMessageIndex(pass, cfg, target.fn)
+	if !ok || target.argOffset > len(call.Args) {
+		return nil, false
+	}
+
+	args := call.Args[target.argOffset:]
+	if msgIndex == firstStringLiteralArg {
+		// У Print-подобных вызовов сообщение целиком задано аргументом,
+		// только если он единственный: log.Println(msg).
+		if len(args) != 1 || !isStringExpr(pass, args[0]) {
+			return nil, false
+		}
+		return args[0], true
+	}
+
+	if msgIndex >= len(args) {
+		return nil, false
+	}
+
+	return args[msgIndex], true
+}
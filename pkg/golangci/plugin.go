@@ -0,0 +1,52 @@
+// Package golangci регистрирует анализатор в системе модульных плагинов
+// golangci-lint: в отличие от .so-плагина из каталога plugin, модульный плагин
+// собирается вместе с golangci-lint командой golangci-lint custom, поэтому
+// версии Go и зависимостей не обязаны совпадать.
+package golangci
+
+import (
+	"github.com/glebpashkov/linter_go/pkg/analyzer"
+	"github.com/golangci/plugin-module-register/register"
+	"golang.org/x/tools/go/analysis"
+)
+
+// Ошибки плагина выводятся на языке из настройки language; ошибки декодера
+// golangci-lint до разбора настроек — на английском.
+var (
+	ErrPluginConfig = analyzer.ErrPluginConfig
+	ErrPluginInit   = analyzer.ErrPluginInit
+)
+
+func init() {
+	register.Plugin(analyzer.AnalyzerName, New)
+}
+
+// Plugin — модульный плагин с уже разобранными настройками.
+type Plugin struct {
+	cfg analyzer.Config
+}
+
+// New разбирает блок settings в analyzer.Config. Ключи задаются в том же
+// написании, что в README (kebab-case); неизвестные ключи отклоняются.
+func New(settings any) (register.LinterPlugin, error) {
+	cfg, err := register.DecodeSettings[analyzer.Config](settings)
+	if err != nil {
+		return nil, analyzer.WrapError(ErrPluginConfig, err)
+	}
+	return &Plugin{cfg: cfg}, nil
+}
+
+// BuildAnalyzers создает анализатор так же, как analyzer.NewAnalyzer.
+func (p *Plugin) BuildAnalyzers() ([]*analysis.Analyzer, error) {
+	a, err := analyzer.NewAnalyzer(p.cfg)
+	if err != nil {
+		return nil, analyzer.WrapError(ErrPluginInit, err)
+	}
+	return []*analysis.Analyzer{a}, nil
+}
+
+// GetLoadMode — анализатору нужна информация о типах: вызовы логгеров
+// распознаются по объектам, а не по именам.
+func (p *Plugin) GetLoadMode() string {
+	return register.LoadModeTypesInfo
+}
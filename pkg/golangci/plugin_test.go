@@ -0,0 +1,140 @@
+package golangci
+
+import (
+	"errors"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/glebpashkov/linter_go/pkg/analyzer"
+	"github.com/golangci/plugin-module-register/register"
+	"golang.org/x/tools/go/analysis/analysistest"
+)
+
+// settings в том виде, в каком golangci-lint передает блок settings из .golangci.yml.
+func testSettings() map[string]any {
+	return map[string]any{
+		"sensitive-allow-patterns": []any{
+			"token bucket",
+			map[string]any{"pattern": `(?i)\bsecret\b`, "allow": []any{"secret santa"}},
+		},
+		"extra-sinks": map[string]any{"github.com/acme/obs.Infof": 1},
+		"rules": map[string]any{
+			"LML001":        map[string]any{"severity": "warning"},
+			"english-only":  map[string]any{"enabled": false},
+			"no-specials":   map[string]any{"enabled": false},
+			"sensitive-arg": map[string]any{"enabled": false},
+		},
+	}
+}
+
+func TestPlugin(t *testing.T) {
+	t.Parallel()
+
+	newPlugin, err := register.GetPlugin(analyzer.AnalyzerName)
+	if err != nil {
+		t.Fatalf("плагин не зарегистрирован: %v", err)
+	}
+
+	plugin, err := newPlugin(testSettings())
+	if err != nil {
+		t.Fatalf("не удалось разобрать настройки: %v", err)
+	}
+	if mode := plugin.GetLoadMode(); mode != register.LoadModeTypesInfo {
+		t.Fatalf("неожиданный режим загрузки: %q", mode)
+	}
+
+	// Типизированный декодер должен давать ту же конфигурацию, что и ParseConfig.
+	want, err := analyzer.ParseConfig(testSettings())
+	if err != nil {
+		t.Fatalf("ParseConfig вернул ошибку: %v", err)
+	}
+	if got := plugin.(*Plugin).cfg; !reflect.DeepEqual(got, want) {
+		t.Fatalf("неожиданная конфигурация: got=%+v want=%+v", got, want)
+	}
+
+	analyzers, err := plugin.BuildAnalyzers()
+	if err != nil {
+		t.Fatalf("не удалось создать анализатор: %v", err)
+	}
+	if len(analyzers) != 1 {
+		t.Fatalf("ожидался один анализатор, получено %d", len(analyzers))
+	}
+
+	expected, err := analyzer.NewAnalyzer(want)
+	if err != nil {
+		t.Fatalf("не удалось создать анализатор: %v", err)
+	}
+	got := analyzers[0]
+	if got.Name != expected.Name || got.Doc != expected.Doc ||
+		len(got.Requires) != len(expected.Requires) || !reflect.DeepEqual(got.FactTypes, expected.FactTypes) {
+		t.Fatalf("анализатор плагина отличается от NewAnalyzer: got=%+v want=%+v", got, expected)
+	}
+
+	// Поведение проверяем на тех же данных, что и TestAnalyzer_Rules.
+	testdata, err := filepath.Abs(filepath.Join("..", "analyzer", "testdata"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	analysistest.Run(t, testdata, got, "rules")
+}
+
+func TestPlugin_Errors(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		settings any
+		wantErr  error
+		wantText string
+	}{
+		{
+			name:     "неизвестный ключ",
+			settings: map[string]any{"sensitive_patterns": []any{"x"}},
+			wantErr:  ErrPluginConfig,
+		},
+		{
+			name:     "неверный тип",
+			settings: map[string]any{"ascii-only": "yes"},
+			wantErr:  ErrPluginConfig,
+		},
+		{
+			name:     "неизвестное правило",
+			settings: map[string]any{"rules": map[string]any{"unknown": map[string]any{"enabled": false}}},
+			wantErr:  ErrPluginInit,
+		},
+		{
+			name:     "невалидный паттерн",
+			settings: map[string]any{"sensitive-patterns": []any{"("}},
+			wantErr:  ErrPluginInit,
+		},
+		{
+			name: "ошибка на языке из настроек",
+			settings: map[string]any{
+				"language": "ru",
+				"rules":    map[string]any{"unknown": map[string]any{"enabled": false}},
+			},
+			wantErr:  analyzer.ErrUnknownRule,
+			wantText: "не удалось создать анализатор: ",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			plugin, err := New(tt.settings)
+			if err == nil {
+				_, err = plugin.BuildAnalyzers()
+			}
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("ожидалась ошибка %v, получено %v", tt.wantErr, err)
+			}
+			if !strings.HasPrefix(err.Error(), tt.wantText) {
+				t.Fatalf("неожиданный текст ошибки: %q", err)
+			}
+		})
+	}
+}
@@ -1,27 +1,27 @@
 package main
 
 import (
-	"errors"
-
 	"github.com/glebpashkov/linter_go/pkg/analyzer"
 	"golang.org/x/tools/go/analysis"
 )
 
+// Ошибки плагина выводятся на языке из настройки language.
 var (
-	ErrPluginConfig = errors.New("не удалось распарсить конфигурацию плагина")
-	ErrPluginInit   = errors.New("не удалось создать анализатор")
+	ErrPluginConfig = analyzer.ErrPluginConfig
+	ErrPluginInit   = analyzer.ErrPluginInit
 )
 
-// New — точка входа плагина для golangci-lint.
+// New — точка входа .so-плагина для golangci-lint. Модульный плагин,
+// который не требует совпадения версий Go, находится в pkg/golangci.
 func New(conf any) ([]*analysis.Analyzer, error) {
 	cfg, err := analyzer.ParseConfig(conf)
 	if err != nil {
-		return nil, errors.Join(ErrPluginConfig, err)
+		return nil, analyzer.WrapError(ErrPluginConfig, err)
 	}
 
 	a, err := analyzer.NewAnalyzer(cfg)
 	if err != nil {
-		return nil, errors.Join(ErrPluginInit, err)
+		return nil, analyzer.WrapError(ErrPluginInit, err)
 	}
 
 	return []*analysis.Analyzer{a}, nil